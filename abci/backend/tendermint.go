@@ -13,6 +13,7 @@ import (
 	"github.com/loomnetwork/loomchain/log"
 	pv "github.com/loomnetwork/loomchain/privval"
 	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
+	remotepv "github.com/loomnetwork/loomchain/privval/remote"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	abci_server "github.com/tendermint/tendermint/abci/server"
@@ -99,6 +100,8 @@ type Backend interface {
 	Reset(height uint64) error
 	Destroy() error
 	Start(app abci.Application) error
+	// Stops the node (or the ABCI server), once this returns the app won't receive any more blocks
+	Stop()
 	RunForever()
 	GenesisValidators() []*loom.Validator
 	// IsValidator checks if this node is currently a validator.
@@ -108,6 +111,11 @@ type Backend interface {
 	NodeSigner() (auth.Signer, error)
 	// Returns the TCP or UNIX socket address the backend RPC server listens on
 	RPCAddress() (string, error)
+	// Returns the double-signing protection state of this node's priv validator
+	ExportSignState() (*pv.SignState, error)
+	// Replaces the double-signing protection state of this node's priv validator, returns true if
+	// the state was replaced, or false if the current state is already identical
+	ImportSignState(state *pv.SignState) (bool, error)
 	EventBus() *types.EventBus // TODO: doesn't seem to be used, remove it
 }
 
@@ -164,6 +172,8 @@ type OverrideConfig struct {
 	CreateEmptyBlocks        bool
 	MempoolWalEnabled        bool
 	HsmConfig                *hsmpv.HsmConfig
+	RemoteSignerConfig       *remotepv.RemoteSignerConfig
+	PrivValSafetyHeight      int64
 	FnConsensusReactorConfig *fnConsensus.ReactorConfigParsable
 }
 
@@ -185,7 +195,9 @@ func (b *TendermintBackend) Init() (*loom.Validator, error) {
 		return nil, errors.New("private validator file already exists")
 	}
 
-	privValidator, err := pv.GenPrivVal(privValFile, b.OverrideCfg.HsmConfig)
+	privValidator, err := pv.GenPrivVal(
+		privValFile, b.OverrideCfg.HsmConfig, b.OverrideCfg.RemoteSignerConfig,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -268,7 +280,13 @@ func (b *TendermintBackend) Reset(height uint64) error {
 	if err != nil {
 		return err
 	}
-	privVal, err := pv.LoadPrivVal(cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig)
+	// The double-signing protection state of a remote signer can only be reset on the signer host
+	if b.OverrideCfg.RemoteSignerConfig.IsEnabled() {
+		return nil
+	}
+	privVal, err := pv.LoadPrivVal(
+		cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig, b.OverrideCfg.RemoteSignerConfig,
+	)
 	if err != nil {
 		return err
 	}
@@ -311,7 +329,9 @@ func (b *TendermintBackend) NodeSigner() (auth.Signer, error) {
 		return nil, err
 	}
 
-	privVal, err := pv.LoadPrivVal(cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig)
+	privVal, err := pv.LoadPrivVal(
+		cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig, b.OverrideCfg.RemoteSignerConfig,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -319,6 +339,38 @@ func (b *TendermintBackend) NodeSigner() (auth.Signer, error) {
 	return pv.NewEd25519Signer(privVal), nil
 }
 
+func (b *TendermintBackend) ExportSignState() (*pv.SignState, error) {
+	cfg, err := b.parseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	privVal, err := pv.LoadPrivVal(
+		cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig, b.OverrideCfg.RemoteSignerConfig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pv.GetSignState(privVal)
+}
+
+func (b *TendermintBackend) ImportSignState(state *pv.SignState) (bool, error) {
+	cfg, err := b.parseConfig()
+	if err != nil {
+		return false, err
+	}
+
+	privVal, err := pv.LoadPrivVal(
+		cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig, b.OverrideCfg.RemoteSignerConfig,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return pv.ImportSignState(privVal, state)
+}
+
 func (b *TendermintBackend) RPCAddress() (string, error) {
 	cfg, err := b.parseConfig()
 	if err != nil {
@@ -366,11 +418,23 @@ func (b *TendermintBackend) Start(app abci.Application) error {
 	}
 	logger := log.NewTMFilter(log.Root, levelOpt)
 	cfg.BaseConfig.LogLevel = b.OverrideCfg.LogLevel
-	privVal, err := pv.LoadPrivVal(cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig)
+	privVal, err := pv.LoadPrivVal(
+		cfg.PrivValidatorFile(), b.OverrideCfg.HsmConfig, b.OverrideCfg.RemoteSignerConfig,
+	)
 	if err != nil {
 		return err
 	}
 
+	// Prevent the validator from signing anything below the safety height, in case the sign state
+	// was lost when the validator was moved to this node.
+	raised, err := pv.EnforceSafetyHeight(privVal, b.OverrideCfg.PrivValSafetyHeight)
+	if err != nil {
+		return err
+	}
+	if raised {
+		logger.Info("Raised priv validator sign state to safety height", "height", b.OverrideCfg.PrivValSafetyHeight)
+	}
+
 	//Load genesis validators
 	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
 	if err != nil {
@@ -464,13 +528,15 @@ func (b *TendermintBackend) EventBus() *types.EventBus {
 	return b.node.EventBus()
 }
 
+func (b *TendermintBackend) Stop() {
+	if (b.node != nil) && b.node.IsRunning() {
+		b.node.Stop()
+	}
+	if (b.socketServer != nil) && b.socketServer.IsRunning() {
+		b.socketServer.Stop()
+	}
+}
+
 func (b *TendermintBackend) RunForever() {
-	cmn.TrapSignal(func() {
-		if (b.node != nil) && b.node.IsRunning() {
-			b.node.Stop()
-		}
-		if (b.socketServer != nil) && b.socketServer.IsRunning() {
-			b.socketServer.Stop()
-		}
-	})
+	cmn.TrapSignal(b.Stop)
 }
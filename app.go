@@ -6,10 +6,13 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/loomnetwork/go-loom/config"
 	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/config/settings"
 	"github.com/loomnetwork/loomchain/eth/utils"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/registry"
@@ -204,7 +207,14 @@ func (s *StoreState) ChangeConfigSetting(name, value string) error {
 	if err != nil {
 		panic(err)
 	}
-	if err := config.SetConfigSetting(cfg, name, value); err != nil {
+	if s.FeatureEnabled(features.ChainCfgVersion1_5, false) {
+		featureEnabled := func(name string) bool {
+			return s.FeatureEnabled(name, false)
+		}
+		if err := settings.DefaultRegistry.Apply(cfg, name, value, featureEnabled); err != nil {
+			return err
+		}
+	} else if err := config.SetConfigSetting(cfg, name, value); err != nil {
 		return err
 	}
 	if err := store.SaveOnChainConfig(s.store, cfg); err != nil {
@@ -351,6 +361,8 @@ type Application struct {
 	curBlockHeader  abci.Header
 	curBlockHash    []byte
 	Store           store.VersionedKVStore
+	// Notified whenever the on-chain config is reloaded, may be nil.
+	SettingsWatcher *settings.Watcher
 	Init            func(State) error
 	TxHandler
 	QueryHandler
@@ -369,6 +381,11 @@ type Application struct {
 	childTxRefs                 []evmaux.ChildTxRef // links Tendermint txs to EVM txs
 	ReceiptsVersion             int32
 	committedTxs                []CommittedTx
+	// height of the last committed block, must be accessed atomically
+	committedHeight int64
+	haltMutex       sync.Mutex
+	haltHeight      int64
+	onHalt          func(height int64)
 }
 
 var _ abci.Application = &Application{}
@@ -447,9 +464,55 @@ func init() {
 }
 
 func (a *Application) Info(req abci.RequestInfo) abci.ResponseInfo {
+	height := a.Store.Version()
+	atomic.StoreInt64(&a.committedHeight, height)
 	return abci.ResponseInfo{
 		LastBlockAppHash: a.Store.Hash(),
-		LastBlockHeight:  a.Store.Version(),
+		LastBlockHeight:  height,
+	}
+}
+
+// LastCommittedHeight returns the height of the last block committed by the app, it's safe to call
+// from any goroutine.
+func (a *Application) LastCommittedHeight() int64 {
+	return atomic.LoadInt64(&a.committedHeight)
+}
+
+// ScheduleHalt arranges for onHalt to be called (from a separate goroutine) as soon as the block at
+// the given height has been committed. The app store is forced to write that block's state to disk,
+// so nothing is lost when the node is shut down by onHalt. If the block has already been committed
+// onHalt is called straight away, in which case any state that wasn't flushed to disk yet will be
+// rebuilt by replaying the last few blocks when the node restarts.
+func (a *Application) ScheduleHalt(height int64, onHalt func(height int64)) {
+	a.haltMutex.Lock()
+	defer a.haltMutex.Unlock()
+
+	store.FlushVersion(a.Store, height)
+	if committedHeight := a.LastCommittedHeight(); committedHeight >= height {
+		a.onHalt = nil
+		go onHalt(committedHeight)
+		return
+	}
+	a.haltHeight = height
+	a.onHalt = onHalt
+}
+
+// CancelHalt cancels a halt previously scheduled via ScheduleHalt.
+func (a *Application) CancelHalt() {
+	a.haltMutex.Lock()
+	defer a.haltMutex.Unlock()
+
+	a.onHalt = nil
+}
+
+func (a *Application) haltIfScheduled(height int64) {
+	a.haltMutex.Lock()
+	defer a.haltMutex.Unlock()
+
+	if a.onHalt != nil && height >= a.haltHeight {
+		// The node can't be stopped from within Commit, so the callback must run in its own goroutine.
+		go a.onHalt(height)
+		a.onHalt = nil
 	}
 }
 
@@ -494,11 +557,7 @@ func (a *Application) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginB
 	}
 
 	if a.config == nil {
-		var err error
-		a.config, err = store.LoadOnChainConfig(a.Store)
-		if err != nil {
-			panic(err)
-		}
+		a.reloadOnChainConfig()
 	}
 
 	a.curBlockHeader = block
@@ -569,9 +628,26 @@ func (a *Application) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginB
 
 	storeTx.Commit()
 
+	if a.config == nil {
+		a.reloadOnChainConfig()
+	}
+
 	return abci.ResponseBeginBlock{}
 }
 
+// reloadOnChainConfig loads the current on-chain config from the app store, and notifies any
+// components that subscribed to config settings that have changed since the last reload.
+func (a *Application) reloadOnChainConfig() {
+	var err error
+	a.config, err = store.LoadOnChainConfig(a.Store)
+	if err != nil {
+		panic(err)
+	}
+	if a.SettingsWatcher != nil {
+		a.SettingsWatcher.Notify(a.config)
+	}
+}
+
 func (a *Application) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "EndBlock"}
@@ -852,6 +928,7 @@ func (a *Application) Commit() abci.ResponseCommit {
 	// Update the last block header before emitting events in case the subscribers attempt to access
 	// the latest committed state as soon as they receive an event.
 	a.lastBlockHeader = a.curBlockHeader
+	atomic.StoreInt64(&a.committedHeight, height)
 
 	go func(height int64, blockHeader abci.Header, committedTxs []CommittedTx) {
 		if err := a.EventHandler.EmitBlockTx(uint64(height), blockHeader.Time); err != nil {
@@ -881,6 +958,8 @@ func (a *Application) Commit() abci.ResponseCommit {
 		log.Error("failed to prune app.db", "err", err)
 	}
 
+	a.haltIfScheduled(height)
+
 	return abci.ResponseCommit{
 		Data: appHash,
 	}
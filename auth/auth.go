@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 
@@ -12,8 +13,10 @@ import (
 	"golang.org/x/crypto/ed25519"
 
 	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/types"
 	"github.com/loomnetwork/go-loom/util"
 	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/store"
 )
 
@@ -39,12 +42,25 @@ func (c contextKey) String() string {
 var (
 	ContextKeyOrigin  = contextKey("origin")
 	ContextKeyCheckTx = contextKey("CheckTx")
+	// Only set for relayed txs, see NewRelayedTxMiddleware.
+	ContextKeyRelayer = contextKey("relayer")
+
+	contextKeyNonceLane = contextKey("nonce-lane")
 )
 
 func Origin(ctx context.Context) loom.Address {
 	return ctx.Value(ContextKeyOrigin).(loom.Address)
 }
 
+// Payer returns the account that should be charged for a tx (karma, rate limits, etc.), this is the
+// relayer that submitted the tx if the tx was relayed, otherwise it's the tx origin.
+func Payer(ctx context.Context) loom.Address {
+	if relayer, ok := ctx.Value(ContextKeyRelayer).(loom.Address); ok {
+		return relayer
+	}
+	return Origin(ctx)
+}
+
 var SignatureTxMiddleware = loomchain.TxMiddlewareFunc(func(
 	state loomchain.State,
 	txBytes []byte,
@@ -95,6 +111,101 @@ func Nonce(state loomchain.ReadOnlyState, addr loom.Address) uint64 {
 	return loomchain.NewSequence(nonceKey(addr)).Value(state)
 }
 
+// NONCE LANES
+//
+// When nonce:lanes is enabled an account can have multiple independent nonce sequences (lanes), so
+// that multiple streams of txs can be submitted concurrently from the same account. The lane is
+// encoded in the upper NonceLaneBits bits of NonceTx.Sequence, and the sequence number within the
+// lane in the remaining bits. Lane zero is the regular account nonce, so txs that don't use lanes
+// are unaffected.
+
+// ErrNonceLaneTxNotAllowed indicates that a tx that can deploy a contract was sent in a non-zero
+// nonce lane.
+var ErrNonceLaneTxNotAllowed = errors.New("contracts can only be deployed from nonce lane zero")
+
+const (
+	NonceLaneBits        = 24
+	nonceLaneSeqBits     = 64 - NonceLaneBits
+	MaxNonceLane         = 1<<NonceLaneBits - 1
+	maxNonceLaneSequence = 1<<nonceLaneSeqBits - 1
+)
+
+// EncodeLaneNonce encodes a nonce lane & the sequence number within that lane into a value that can
+// be used as the sequence number of a NonceTx.
+func EncodeLaneNonce(lane uint32, seq uint64) (uint64, error) {
+	if lane > MaxNonceLane {
+		return 0, fmt.Errorf("nonce lane %d exceeds max lane %d", lane, MaxNonceLane)
+	}
+	if seq > maxNonceLaneSequence {
+		return 0, fmt.Errorf("sequence number %d exceeds max lane sequence number", seq)
+	}
+	return uint64(lane)<<nonceLaneSeqBits | seq, nil
+}
+
+// DecodeLaneNonce decodes the sequence number of a NonceTx into a nonce lane & the sequence number
+// within that lane.
+func DecodeLaneNonce(nonce uint64) (uint32, uint64) {
+	return uint32(nonce >> nonceLaneSeqBits), nonce & maxNonceLaneSequence
+}
+
+func nonceLaneKey(addr loom.Address, lane uint32) []byte {
+	if lane == 0 {
+		return nonceKey(addr)
+	}
+	laneBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(laneBytes, lane)
+	return util.PrefixKey([]byte("nonce-lane"), addr.Bytes(), laneBytes)
+}
+
+func nonceCacheKey(addr loom.Address, lane uint32) string {
+	if lane == 0 {
+		return addr.String()
+	}
+	return fmt.Sprintf("%s/%d", addr.String(), lane)
+}
+
+// LaneNonce returns the sequence number of the last committed tx sent by the given account in the
+// given nonce lane.
+func LaneNonce(state loomchain.ReadOnlyState, addr loom.Address, lane uint32) uint64 {
+	return loomchain.NewSequence(nonceLaneKey(addr, lane)).Value(state)
+}
+
+func nonceLane(ctx context.Context) uint32 {
+	if lane, ok := ctx.Value(contextKeyNonceLane).(uint32); ok {
+		return lane
+	}
+	return 0
+}
+
+// TxNonce returns the nonce of the tx currently being processed for the given account, i.e. the
+// lane encoded nonce if the tx was sent in a non-zero nonce lane, and the account nonce otherwise.
+// Should be used instead of Nonce wherever the nonce is used to identify a tx, e.g. in receipts.
+func TxNonce(state loomchain.State, addr loom.Address) uint64 {
+	lane := nonceLane(state.Context())
+	if lane == 0 {
+		return Nonce(state, addr)
+	}
+	// The lane & sequence were validated by the NonceHandler so this can't fail.
+	nonce, _ := EncodeLaneNonce(lane, LaneNonce(state, addr, lane))
+	return nonce
+}
+
+// checkNonceLaneTx checks that the given tx can be sent in a non-zero nonce lane. Contract
+// addresses are derived from the account nonce, which doesn't change when txs are sent in other
+// lanes, so deploying contracts from multiple lanes would result in address collisions. Ethereum
+// txs carry their own nonce, and may deploy contracts too, so they're also restricted to lane zero.
+func checkNonceLaneTx(txBytes []byte) error {
+	var tx types.Transaction
+	if err := proto.Unmarshal(txBytes, &tx); err != nil {
+		return errors.New("failed to unmarshal Transaction")
+	}
+	switch types.TxID(tx.Id) {
+	case types.TxID_DEPLOY, types.TxID_ETHEREUM:
+		return ErrNonceLaneTxNotAllowed
+	}
+	return nil
+}
+
 type NonceHandler struct {
 	nonceCache map[string]uint64 // stores the next nonce expected to be seen for each account
 	lastHeight int64
@@ -112,6 +223,8 @@ func (n *NonceHandler) Nonce(
 	isCheckTx bool,
 ) (loomchain.TxHandlerResult, error) {
 	var r loomchain.TxHandlerResult
+	// For relayed txs the origin is the user that signed the relayed tx, not the relayer, so the
+	// nonce of the user is checked & incremented.
 	origin := Origin(state.Context())
 	if origin.IsEmpty() {
 		return r, errors.New("transaction has no origin [nonce]")
@@ -123,22 +236,41 @@ func (n *NonceHandler) Nonce(
 	}
 	var seq uint64
 
+	var tx NonceTx
+	txErr := proto.Unmarshal(txBytes, &tx)
+
+	// Expired txs are rejected before the nonce is incremented, so they never consume a nonce.
+	if txErr == nil && state.FeatureEnabled(features.TxExpiryFeature, false) {
+		if err := checkTxExpiry(state, txBytes, isCheckTx); err != nil {
+			return r, err
+		}
+	}
+
+	lane, txSeq := uint32(0), tx.Sequence
+	if txErr == nil && state.FeatureEnabled(features.NonceLanesFeature, false) {
+		lane, txSeq = DecodeLaneNonce(tx.Sequence)
+		if lane != 0 {
+			if err := checkNonceLaneTx(tx.Inner); err != nil {
+				return r, err
+			}
+		}
+	}
+	cacheKey := nonceCacheKey(origin, lane)
+
 	incrementNonceOnFailedTx := state.Config().GetNonceHandler().GetIncNonceOnFailedTx()
 	if incrementNonceOnFailedTx && !isCheckTx {
 		// Unconditionally increment the nonce in DeliverTx, regardless of whether the tx succeeds
-		seq = loomchain.NewSequence(nonceKey(origin)).Next(kvStore)
+		seq = loomchain.NewSequence(nonceLaneKey(origin, lane)).Next(kvStore)
 	} else {
-		seq = loomchain.NewSequence(nonceKey(origin)).Next(state)
+		seq = loomchain.NewSequence(nonceLaneKey(origin, lane)).Next(state)
 	}
 
-	var tx NonceTx
-	err := proto.Unmarshal(txBytes, &tx)
-	if err != nil {
-		return r, err
+	if txErr != nil {
+		return r, txErr
 	}
 
 	//TODO nonce cache is temporary until we have a separate atomic state for the entire checktx flow
-	cacheSeq := n.nonceCache[origin.String()]
+	cacheSeq := n.nonceCache[cacheKey]
 	// The client may speculatively increment nonces without waiting for previous txs to be committed,
 	// so it's possible for a single account to submit multiple transactions in a single block.
 	if cacheSeq != 0 && isCheckTx {
@@ -147,23 +279,32 @@ func (n *NonceHandler) Nonce(
 	} else {
 		if incrementNonceOnFailedTx {
 			if isCheckTx {
-				n.nonceCache[origin.String()] = seq
+				n.nonceCache[cacheKey] = seq
 			} else {
 				// In DeliverTx we update the cache unconditionally, because even if the tx fails the
 				// nonce change will be persisted. We do this here because post commit middleware doesn't
 				// run for failed txs, so IncNonce can't be relied upon.
-				n.nonceCache[origin.String()] = seq + 1
+				n.nonceCache[cacheKey] = seq + 1
 			}
 		} else {
-			n.nonceCache[origin.String()] = seq
+			n.nonceCache[cacheKey] = seq
 		}
 	}
 
-	if tx.Sequence != seq {
+	if txSeq != seq {
 		nonceErrorCount.Add(1)
-		return r, fmt.Errorf("sequence number does not match expected %d got %d", seq, tx.Sequence)
+		if lane != 0 {
+			return r, fmt.Errorf(
+				"sequence number does not match expected %d got %d (nonce lane %d)", seq, txSeq, lane,
+			)
+		}
+		return r, fmt.Errorf("sequence number does not match expected %d got %d", seq, txSeq)
 	}
 
+	if lane != 0 {
+		// IncNonce needs to know which lane to increment
+		state = state.WithContext(context.WithValue(state.Context(), contextKeyNonceLane, lane))
+	}
 	return next(state, tx.Inner, isCheckTx)
 }
 
@@ -179,14 +320,15 @@ func (n *NonceHandler) IncNonce(
 		return errors.New("transaction has no origin [IncNonce]")
 	}
 
+	cacheKey := nonceCacheKey(origin, nonceLane(state.Context()))
 	// We only increment the nonce if the transaction is successful
 	// There are situations in checktx where we may not have committed the transaction to the statestore yet
 	if state.Config().GetNonceHandler().GetIncNonceOnFailedTx() {
 		if isCheckTx {
-			n.nonceCache[origin.String()] = n.nonceCache[origin.String()] + 1
+			n.nonceCache[cacheKey] = n.nonceCache[cacheKey] + 1
 		}
 	} else {
-		n.nonceCache[origin.String()] = n.nonceCache[origin.String()] + 1
+		n.nonceCache[cacheKey] = n.nonceCache[cacheKey] + 1
 	}
 	return nil
 }
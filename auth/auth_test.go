@@ -2,10 +2,11 @@ package auth
 
 import (
 	"context"
-	"errors"
 	"testing"
+	"time"
 
 	proto "github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"golang.org/x/crypto/ed25519"
@@ -13,7 +14,9 @@ import (
 	loom "github.com/loomnetwork/go-loom"
 	"github.com/loomnetwork/go-loom/auth"
 	"github.com/loomnetwork/go-loom/config"
+	"github.com/loomnetwork/go-loom/types"
 	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/store"
 )
 
@@ -221,3 +224,142 @@ func TestRevertedTxNonceMiddleware(t *testing.T) {
 	currentNonce = Nonce(state, origin)
 	require.Equal(t, uint64(2), currentNonce)
 }
+
+func TestNonceLanes(t *testing.T) {
+	nonceTxHandler := NewNonceHandler()
+
+	pubkey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	origin := loom.Address{
+		ChainID: "default",
+		Local:   loom.LocalAddressFromPublicKey(pubkey),
+	}
+
+	lane := uint32(5)
+	makeNonceTx := func(lane uint32, seq uint64) []byte {
+		nonce, err := EncodeLaneNonce(lane, seq)
+		require.NoError(t, err)
+		nonceTxBytes, err := proto.Marshal(&NonceTx{Inner: []byte{}, Sequence: nonce})
+		require.NoError(t, err)
+		return nonceTxBytes
+	}
+	processTx := func(state loomchain.State, kvStore store.KVStore, nonceTxBytes []byte, expectedLane uint32) error {
+		_, err := nonceTxHandler.Nonce(state, kvStore, nonceTxBytes,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				require.Equal(t, expectedLane, nonceLane(state.Context()))
+				return loomchain.TxHandlerResult{}, nil
+			}, false,
+		)
+		return err
+	}
+
+	nonce, err := EncodeLaneNonce(lane, 42)
+	require.NoError(t, err)
+	decodedLane, decodedSeq := DecodeLaneNonce(nonce)
+	require.Equal(t, lane, decodedLane)
+	require.Equal(t, uint64(42), decodedSeq)
+	_, err = EncodeLaneNonce(MaxNonceLane+1, 1)
+	require.Error(t, err)
+
+	ctx := context.WithValue(context.Background(), ContextKeyOrigin, origin)
+	newState := func() (loomchain.State, store.KVStore) {
+		kvStore := store.NewMemStore()
+		state := loomchain.NewStoreState(ctx, kvStore, abci.Header{Height: 27}, nil, nil).
+			WithOnChainConfig(config.DefaultConfig())
+		return state, kvStore
+	}
+
+	// Lanes can't be used until the feature is enabled
+	state, kvStore := newState()
+	require.Error(t, processTx(state, kvStore, makeNonceTx(lane, 1), lane))
+
+	state, kvStore = newState()
+	state.SetFeature(features.NonceLanesFeature, true)
+	require.NoError(t, processTx(state, kvStore, makeNonceTx(0, 1), 0))
+	// Each lane has its own sequence
+	require.NoError(t, processTx(state, kvStore, makeNonceTx(lane, 1), lane))
+	require.Error(t, processTx(state, kvStore, makeNonceTx(lane, 1), lane))
+	require.NoError(t, processTx(state, kvStore, makeNonceTx(lane, 2), lane))
+	require.NoError(t, processTx(state, kvStore, makeNonceTx(0, 2), 0))
+
+	require.Equal(t, uint64(2), Nonce(state, origin))
+	require.Equal(t, uint64(2), LaneNonce(state, origin, lane))
+	require.Equal(t, uint64(0), LaneNonce(state, origin, lane+1))
+
+	// Contracts can only be deployed from lane zero, since contract addresses are derived from the
+	// account nonce
+	makeTxNonceTx := func(lane uint32, seq uint64, txID types.TxID) []byte {
+		txBytes, err := proto.Marshal(&types.Transaction{Id: uint32(txID), Data: []byte("tx")})
+		require.NoError(t, err)
+		nonce, err := EncodeLaneNonce(lane, seq)
+		require.NoError(t, err)
+		nonceTxBytes, err := proto.Marshal(&NonceTx{Inner: txBytes, Sequence: nonce})
+		require.NoError(t, err)
+		return nonceTxBytes
+	}
+	require.NoError(t, processTx(state, kvStore, makeTxNonceTx(0, 3, types.TxID_DEPLOY), 0))
+	err = processTx(state, kvStore, makeTxNonceTx(lane, 3, types.TxID_DEPLOY), lane)
+	require.Equal(t, ErrNonceLaneTxNotAllowed, err)
+	err = processTx(state, kvStore, makeTxNonceTx(lane, 3, types.TxID_ETHEREUM), lane)
+	require.Equal(t, ErrNonceLaneTxNotAllowed, err)
+	// the rejected deploys don't consume a nonce in the lane
+	require.Equal(t, uint64(2), LaneNonce(state, origin, lane))
+	require.NoError(t, processTx(state, kvStore, makeTxNonceTx(lane, 3, types.TxID_CALL), lane))
+
+	// The nonce of a lane tx identifies the tx in receipts
+	laneState := state.WithContext(context.WithValue(state.Context(), contextKeyNonceLane, lane))
+	laneNonce, err := EncodeLaneNonce(lane, 3)
+	require.NoError(t, err)
+	require.Equal(t, laneNonce, TxNonce(laneState, origin))
+	require.Equal(t, uint64(3), TxNonce(state, origin))
+}
+
+func TestTxExpiry(t *testing.T) {
+	nonceTxHandler := NewNonceHandler()
+
+	pubkey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	origin := loom.Address{
+		ChainID: "default",
+		Local:   loom.LocalAddressFromPublicKey(pubkey),
+	}
+	ctx := context.WithValue(context.Background(), ContextKeyOrigin, origin)
+	blockTime := time.Unix(1500000000, 0)
+	kvStore := store.NewMemStore()
+	state := loomchain.NewStoreState(ctx, kvStore, abci.Header{Height: 27, Time: blockTime}, nil, nil).
+		WithOnChainConfig(config.DefaultConfig())
+	state.SetFeature(features.TxExpiryFeature, true)
+
+	processTx := func(tx *ExpiringNonceTx, isCheckTx bool) error {
+		txBytes, err := proto.Marshal(tx)
+		require.NoError(t, err)
+		_, err = nonceTxHandler.Nonce(state, kvStore, txBytes,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				return loomchain.TxHandlerResult{}, nil
+			}, isCheckTx,
+		)
+		return err
+	}
+
+	// The next block will be 28, so the tx can't be included in a block anymore
+	err = processTx(&ExpiringNonceTx{Sequence: 1, ValidUntilHeight: 27}, true)
+	require.Equal(t, ErrTxExpired, errors.Cause(err))
+	require.NoError(t, processTx(&ExpiringNonceTx{Sequence: 1, ValidUntilHeight: 27}, false))
+
+	err = processTx(&ExpiringNonceTx{Sequence: 2, ValidUntilTime: blockTime.Unix() - 1}, false)
+	require.Equal(t, ErrTxExpired, errors.Cause(err))
+	// Expired txs don't consume the nonce
+	require.NoError(t, processTx(&ExpiringNonceTx{Sequence: 2, ValidUntilTime: blockTime.Unix()}, false))
+	// Txs without an expiry never expire
+	require.NoError(t, processTx(&ExpiringNonceTx{Sequence: 3}, false))
+
+	// Regular NonceTx(s) are unaffected
+	nonceTxBytes, err := proto.Marshal(&NonceTx{Inner: []byte{}, Sequence: 4})
+	require.NoError(t, err)
+	_, err = nonceTxHandler.Nonce(state, kvStore, nonceTxBytes,
+		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+			return loomchain.TxHandlerResult{}, nil
+		}, false,
+	)
+	require.NoError(t, err)
+}
@@ -11,7 +11,8 @@ import (
 
 // NewChainConfigMiddleware returns middleware that verifies signed txs using either
 // SignedTxMiddleware or MultiChainSignatureTxMiddleware, it switches the underlying middleware
-// based on the on-chain and off-chain auth config settings.
+// based on the on-chain and off-chain auth config settings. Relayed txs are unwrapped by the
+// same middleware when tx:relayed is enabled.
 func NewChainConfigMiddleware(
 	authConfig *Config,
 	createAddressMapperCtx func(state loomchain.State) (contractpb.StaticContext, error),
@@ -22,13 +23,16 @@ func NewChainConfigMiddleware(
 		next loomchain.TxHandlerFunc,
 		isCheckTx bool,
 	) (loomchain.TxHandlerResult, error) {
+		mw := SignatureTxMiddleware
 		chains := getEnabledChains(authConfig.Chains, state)
 		if len(chains) > 0 {
-			mw := NewMultiChainSignatureTxMiddleware(chains, createAddressMapperCtx)
-			return mw(state, txBytes, next, isCheckTx)
+			mw = NewMultiChainSignatureTxMiddleware(chains, createAddressMapperCtx)
 		}
 
-		return SignatureTxMiddleware(state, txBytes, next, isCheckTx)
+		if state.FeatureEnabled(features.RelayedTxFeature, false) {
+			mw = NewRelayedTxMiddleware(mw)
+		}
+		return mw(state, txBytes, next, isCheckTx)
 	})
 }
 
@@ -0,0 +1,118 @@
+package keys
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/btcsuite/btcutil/bech32"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/pkg/errors"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// ParseCosmosPubKey parses an amino encoded secp256k1 public key, as used by Cosmos SDK based chains.
+func ParseCosmosPubKey(pubKey []byte) (secp256k1.PubKeySecp256k1, error) {
+	pk, err := cryptoAmino.PubKeyFromBytes(pubKey)
+	if err != nil {
+		return secp256k1.PubKeySecp256k1{}, errors.Wrap(err, "failed to decode amino public key")
+	}
+	secpPubKey, ok := pk.(secp256k1.PubKeySecp256k1)
+	if !ok {
+		return secp256k1.PubKeySecp256k1{}, errors.Errorf("unsupported public key type %T", pk)
+	}
+	return secpPubKey, nil
+}
+
+// LocalAddressFromCosmosPubKey derives an account address from an amino encoded secp256k1 public
+// key in the same way as Cosmos SDK based chains, i.e. RIPEMD-160(SHA-256(compressed public key)).
+func LocalAddressFromCosmosPubKey(pubKey []byte) (loom.LocalAddress, error) {
+	pk, err := ParseCosmosPubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return loom.LocalAddress(pk.Address()), nil
+}
+
+// CosmosBech32Address encodes an account address with the given bech32 prefix, e.g. cosmos1...
+func CosmosBech32Address(bech32Prefix string, addr loom.LocalAddress) (string, error) {
+	data, err := bech32.ConvertBits(addr, 8, 5, true)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert address to bech32")
+	}
+	return bech32.Encode(bech32Prefix, data)
+}
+
+// The amino JSON encoding of an ADR-036 sign doc, the struct fields must be kept in alphabetical
+// order since the JSON sign bytes must have sorted keys.
+type cosmosSignDoc struct {
+	AccountNumber string             `json:"account_number"`
+	ChainID       string             `json:"chain_id"`
+	Fee           cosmosSignDocFee   `json:"fee"`
+	Memo          string             `json:"memo"`
+	Msgs          []cosmosSignDocMsg `json:"msgs"`
+	Sequence      string             `json:"sequence"`
+}
+
+type cosmosSignDocFee struct {
+	Amount []struct{} `json:"amount"`
+	Gas    string     `json:"gas"`
+}
+
+type cosmosSignDocMsg struct {
+	Type  string                 `json:"type"`
+	Value cosmosMsgSignDataValue `json:"value"`
+}
+
+type cosmosMsgSignDataValue struct {
+	Data   string `json:"data"`
+	Signer string `json:"signer"`
+}
+
+// CosmosSignBytes returns the bytes a Cosmos wallet signs when asked to sign arbitrary data, i.e.
+// the amino JSON encoding of an ADR-036 sign doc wrapping the data in a MsgSignData. The signer
+// field of the sign doc is the bech32 encoded address derived from the given public key.
+func CosmosSignBytes(bech32Prefix string, pubKey []byte, data []byte) ([]byte, error) {
+	addr, err := LocalAddressFromCosmosPubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := CosmosBech32Address(bech32Prefix, addr)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(cosmosSignDoc{
+		AccountNumber: "0",
+		ChainID:       "",
+		Fee:           cosmosSignDocFee{Amount: []struct{}{}, Gas: "0"},
+		Memo:          "",
+		Msgs: []cosmosSignDocMsg{
+			{
+				Type: "sign/MsgSignData",
+				Value: cosmosMsgSignDataValue{
+					Data:   base64.StdEncoding.EncodeToString(data),
+					Signer: signer,
+				},
+			},
+		},
+		Sequence: "0",
+	})
+}
+
+// VerifyCosmos verifies an ADR-036 signature of the given data produced by a secp256k1 key, which
+// is how Cosmos wallets sign arbitrary data. The bech32 prefix is used to encode the signer address
+// in the sign doc, and must match the prefix of the chain the key belongs to.
+func VerifyCosmos(pubKey []byte, bech32Prefix string, data []byte, sig []byte) error {
+	pk, err := ParseCosmosPubKey(pubKey)
+	if err != nil {
+		return err
+	}
+	signBytes, err := CosmosSignBytes(bech32Prefix, pubKey, data)
+	if err != nil {
+		return err
+	}
+	if !pk.VerifyBytes(signBytes, sig) {
+		return errors.New("invalid secp256k1 signature")
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestSecp256r1Signature(t *testing.T) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey := elliptic.Marshal(elliptic.P256(), privKey.X, privKey.Y)
+
+	msg := []byte("hello")
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, privKey, hash[:])
+	require.NoError(t, err)
+	sig := make([]byte, Secp256r1SignatureSize)
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rBytes):32], rBytes)
+	copy(sig[64-len(sBytes):], sBytes)
+
+	require.NoError(t, VerifySecp256r1(pubKey, msg, sig))
+	require.Error(t, VerifySecp256r1(pubKey, []byte("world"), sig))
+
+	addr, err := LocalAddressFromSecp256r1PubKey(pubKey)
+	require.NoError(t, err)
+	require.Len(t, addr, 20)
+
+	_, err = ParseSecp256r1PubKey(pubKey[1:])
+	require.Error(t, err)
+}
+
+func TestWebAuthnAssertion(t *testing.T) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey := elliptic.Marshal(elliptic.P256(), privKey.X, privKey.Y)
+
+	msg := []byte("hello")
+	makeAssertion := func(msg []byte, flags byte) []byte {
+		msgHash := sha256.Sum256(msg)
+		clientData, err := json.Marshal(webAuthnClientData{
+			Type:      webAuthnAssertionType,
+			Challenge: base64.RawURLEncoding.EncodeToString(msgHash[:]),
+			Origin:    "https://loomx.io",
+		})
+		require.NoError(t, err)
+		authData := make([]byte, minAuthenticatorDataSize)
+		authData[authenticatorDataFlagsIdx] = flags
+
+		clientDataHash := sha256.Sum256(clientData)
+		signedHash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+		r, s, err := ecdsa.Sign(rand.Reader, privKey, signedHash[:])
+		require.NoError(t, err)
+		sig, err := asn1.Marshal(ecdsaSignature{R: r, S: s})
+		require.NoError(t, err)
+
+		assertion, err := json.Marshal(WebAuthnAssertion{
+			AuthenticatorData: authData,
+			ClientDataJSON:    clientData,
+			Signature:         sig,
+		})
+		require.NoError(t, err)
+		return assertion
+	}
+
+	require.NoError(t, VerifySecp256r1(pubKey, msg, makeAssertion(msg, authenticatorDataFlagUP)))
+	// challenge doesn't match the message
+	require.Error(t, VerifySecp256r1(pubKey, msg, makeAssertion([]byte("world"), authenticatorDataFlagUP)))
+	// user presence flag not set
+	require.Error(t, VerifySecp256r1(pubKey, msg, makeAssertion(msg, 0)))
+}
+
+func TestCosmosSignature(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().Bytes()
+
+	msg := []byte("hello")
+	signBytes, err := CosmosSignBytes("cosmos", pubKey, msg)
+	require.NoError(t, err)
+	signer, err := CosmosBech32Address("cosmos", loom.LocalAddress(privKey.PubKey().Address()))
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",`+
+			`"msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"`+signer+`"}}],`+
+			`"sequence":"0"}`,
+		string(signBytes),
+	)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+
+	require.NoError(t, VerifyCosmos(pubKey, "cosmos", msg, sig))
+	require.Error(t, VerifyCosmos(pubKey, "cosmos", []byte("world"), sig))
+	// the signer address in the sign doc must use the same bech32 prefix
+	require.Error(t, VerifyCosmos(pubKey, "osmo", msg, sig))
+	// raw signatures of the data aren't accepted
+	rawSig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Error(t, VerifyCosmos(pubKey, "cosmos", msg, rawSig))
+
+	addr, err := LocalAddressFromCosmosPubKey(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte(privKey.PubKey().Address()), []byte(addr))
+}
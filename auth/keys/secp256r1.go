@@ -0,0 +1,63 @@
+// Package keys implements verification of signatures produced by keys that can't be used with
+// evmcompat typed signatures, i.e. keys whose public key can't be recovered from the signature.
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Size of an uncompressed secp256r1 public key (0x04 || X || Y)
+	Secp256r1PubKeySize = 65
+	// Size of a raw secp256r1 signature (R || S)
+	Secp256r1SignatureSize = 64
+)
+
+// ParseSecp256r1PubKey parses an uncompressed secp256r1 (P-256) public key.
+func ParseSecp256r1PubKey(pubKey []byte) (*ecdsa.PublicKey, error) {
+	if len(pubKey) != Secp256r1PubKeySize {
+		return nil, errors.New("invalid secp256r1 public key length")
+	}
+	x, y := elliptic.Unmarshal(elliptic.P256(), pubKey)
+	if x == nil {
+		return nil, errors.New("invalid secp256r1 public key")
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// LocalAddressFromSecp256r1PubKey derives an account address from a secp256r1 public key in the
+// same way Ethereum derives an address from a secp256k1 public key, i.e. the last 20 bytes of the
+// Keccak-256 hash of the uncompressed public key (without the 0x04 prefix).
+func LocalAddressFromSecp256r1PubKey(pubKey []byte) (loom.LocalAddress, error) {
+	if _, err := ParseSecp256r1PubKey(pubKey); err != nil {
+		return nil, err
+	}
+	return loom.LocalAddress(crypto.Keccak256(pubKey[1:])[12:]), nil
+}
+
+// VerifySecp256r1 verifies a signature of the given message produced by a secp256r1 key.
+// The signature can either be a raw 64-byte signature (R || S) of the SHA-256 hash of the
+// message, or a WebAuthn assertion (see VerifyWebAuthnAssertion).
+func VerifySecp256r1(pubKey []byte, msg []byte, sig []byte) error {
+	pk, err := ParseSecp256r1PubKey(pubKey)
+	if err != nil {
+		return err
+	}
+	if len(sig) != Secp256r1SignatureSize {
+		return verifyWebAuthnAssertion(pk, msg, sig)
+	}
+	hash := sha256.Sum256(msg)
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(pk, hash[:], r, s) {
+		return errors.New("invalid secp256r1 signature")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// The authenticator data consists of the RP ID hash (32 bytes), flags (1 byte), and the
+	// signature counter (4 bytes), optionally followed by extensions.
+	minAuthenticatorDataSize  = 37
+	authenticatorDataFlagsIdx = 32
+	// User Present flag
+	authenticatorDataFlagUP = 0x01
+
+	webAuthnAssertionType = "webauthn.get"
+)
+
+// WebAuthnAssertion is the JSON encoded signature produced by a WebAuthn authenticator
+// (e.g. a passkey) via navigator.credentials.get(). The challenge passed to the authenticator must
+// be the SHA-256 hash of the signed message.
+type WebAuthnAssertion struct {
+	AuthenticatorData []byte `json:"authenticatorData"`
+	ClientDataJSON    []byte `json:"clientDataJSON"`
+	// ASN.1 DER encoded ECDSA signature
+	Signature []byte `json:"signature"`
+}
+
+type webAuthnClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// VerifyWebAuthnAssertion verifies a JSON encoded WebAuthn assertion of the given message
+// produced by a secp256r1 key.
+func VerifyWebAuthnAssertion(pubKey []byte, msg []byte, assertion []byte) error {
+	pk, err := ParseSecp256r1PubKey(pubKey)
+	if err != nil {
+		return err
+	}
+	return verifyWebAuthnAssertion(pk, msg, assertion)
+}
+
+func verifyWebAuthnAssertion(pk *ecdsa.PublicKey, msg []byte, assertionJSON []byte) error {
+	var assertion WebAuthnAssertion
+	if err := json.Unmarshal(assertionJSON, &assertion); err != nil {
+		return errors.Wrap(err, "failed to unmarshal WebAuthn assertion")
+	}
+
+	var clientData webAuthnClientData
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return errors.Wrap(err, "failed to unmarshal WebAuthn client data")
+	}
+	if clientData.Type != webAuthnAssertionType {
+		return errors.Errorf("invalid WebAuthn client data type %s", clientData.Type)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(clientData.Challenge)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode WebAuthn challenge")
+	}
+	msgHash := sha256.Sum256(msg)
+	if !bytes.Equal(challenge, msgHash[:]) {
+		return errors.New("WebAuthn challenge doesn't match the message")
+	}
+
+	if len(assertion.AuthenticatorData) < minAuthenticatorDataSize {
+		return errors.New("invalid WebAuthn authenticator data length")
+	}
+	if assertion.AuthenticatorData[authenticatorDataFlagsIdx]&authenticatorDataFlagUP == 0 {
+		return errors.New("WebAuthn user presence flag not set")
+	}
+
+	var sig ecdsaSignature
+	rest, err := asn1.Unmarshal(assertion.Signature, &sig)
+	if err != nil || len(rest) > 0 {
+		return errors.New("invalid WebAuthn signature encoding")
+	}
+
+	// The authenticator signs the authenticator data concatenated with the hash of the client data
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signedData := append(append([]byte{}, assertion.AuthenticatorData...), clientDataHash[:]...)
+	signedHash := sha256.Sum256(signedData)
+	if sig.R == nil || sig.S == nil || !ecdsa.Verify(pk, signedHash[:], sig.R, sig.S) {
+		return errors.New("invalid WebAuthn signature")
+	}
+	return nil
+}
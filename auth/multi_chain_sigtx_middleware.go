@@ -13,6 +13,7 @@ import (
 	"github.com/loomnetwork/go-loom/types"
 	"github.com/loomnetwork/go-loom/vm"
 	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/auth/keys"
 	"github.com/loomnetwork/loomchain/builtin/plugins/address_mapper"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/pkg/errors"
@@ -26,6 +27,10 @@ const (
 	EthereumSignedTxType SignedTxType = "eth"
 	TronSignedTxType     SignedTxType = "tron"
 	BinanceSignedTxType  SignedTxType = "binance"
+	// secp256r1 (P-256) signatures, including WebAuthn assertions produced by passkeys
+	Secp256r1SignedTxType SignedTxType = "secp256r1"
+	// Cosmos SDK style secp256k1 signatures, with amino encoded public keys
+	CosmosSignedTxType SignedTxType = "cosmos"
 )
 
 // AccountType is used to specify which address should be used on-chain to identify a tx sender.
@@ -88,7 +93,7 @@ func NewMultiChainSignatureTxMiddleware(
 			return r, fmt.Errorf("unknown chain ID %s", msgSender.ChainID)
 		}
 
-		recoverOrigin := getOriginRecoveryFunc(state, types.TxID(tx.Id), chain.TxType)
+		recoverOrigin := getOriginRecoveryFunc(state, types.TxID(tx.Id), msgSender.ChainID, chain.TxType)
 		if recoverOrigin == nil {
 			return r, fmt.Errorf("recovery function for Tx type %v not found", chain.TxType)
 		}
@@ -108,6 +113,21 @@ func NewMultiChainSignatureTxMiddleware(
 			)
 		}
 
+		if state.FeatureEnabled(features.MultiChainSigTxMiddlewareVersion1_2, false) {
+			owner, isSessionKey, err := resolveSessionKey(state, msgSender, &tx, &msg, createAddressMapperCtx)
+			if err != nil {
+				return r, errors.Wrapf(err, "session key %s", msgSender.String())
+			}
+			if isSessionKey { // the session key signs on behalf of the account that authorized it
+				nonceTxBytes, err := replaceTxSender(&nonceTx, &tx, &msg, owner)
+				if err != nil {
+					return r, err
+				}
+				ctx := context.WithValue(state.Context(), ContextKeyOrigin, owner)
+				return next(state.WithContext(ctx), nonceTxBytes, isCheckTx)
+			}
+		}
+
 		switch chain.AccountType {
 		case NativeAccountType: // pass through origin & message sender as is
 			ctx := context.WithValue(state.Context(), ContextKeyOrigin, msgSender)
@@ -119,22 +139,9 @@ func NewMultiChainSignatureTxMiddleware(
 				return r, err
 			}
 
-			msg.From = origin.MarshalPB()
-			msgTxBytes, err := proto.Marshal(&msg)
-			if err != nil {
-				return r, errors.Wrap(err, "failed to marshal MessageTx")
-			}
-
-			tx.Data = msgTxBytes
-			txBytes, err := proto.Marshal(&tx)
+			nonceTxBytes, err := replaceTxSender(&nonceTx, &tx, &msg, origin)
 			if err != nil {
-				return r, errors.Wrap(err, "failed to marshal Transaction")
-			}
-
-			nonceTx.Inner = txBytes
-			nonceTxBytes, err := proto.Marshal(&nonceTx)
-			if err != nil {
-				return r, errors.Wrap(err, "failed to marshal NonceTx")
+				return r, err
 			}
 
 			ctx := context.WithValue(state.Context(), ContextKeyOrigin, origin)
@@ -146,7 +153,33 @@ func NewMultiChainSignatureTxMiddleware(
 	})
 }
 
-func getOriginRecoveryFunc(state loomchain.State, txID types.TxID, txType SignedTxType) originRecoveryFunc {
+// replaceTxSender replaces the sender of the given tx, and returns the re-encoded NonceTx.
+func replaceTxSender(
+	nonceTx *NonceTx, tx *types.Transaction, msg *vm.MessageTx, sender loom.Address,
+) ([]byte, error) {
+	msg.From = sender.MarshalPB()
+	msgTxBytes, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal MessageTx")
+	}
+
+	tx.Data = msgTxBytes
+	txBytes, err := proto.Marshal(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal Transaction")
+	}
+
+	nonceTx.Inner = txBytes
+	nonceTxBytes, err := proto.Marshal(nonceTx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal NonceTx")
+	}
+	return nonceTxBytes, nil
+}
+
+func getOriginRecoveryFunc(
+	state loomchain.State, txID types.TxID, chainID string, txType SignedTxType,
+) originRecoveryFunc {
 	switch txType {
 	case LoomSignedTxType:
 		return verifyEd25519
@@ -159,6 +192,14 @@ func getOriginRecoveryFunc(state loomchain.State, txID types.TxID, txType Signed
 		return verifyTron
 	case BinanceSignedTxType:
 		return verifyBinance
+	case Secp256r1SignedTxType:
+		if state.FeatureEnabled(features.AuthSigTxFeaturePrefix+chainID, false) {
+			return verifySecp256r1
+		}
+	case CosmosSignedTxType:
+		if state.FeatureEnabled(features.AuthSigTxFeaturePrefix+chainID, false) {
+			return newCosmosOriginRecoveryFunc(chainID)
+		}
 	}
 	return nil
 }
@@ -206,6 +247,28 @@ func verifyEd25519(chainID string, tx SignedTx, _ []evmcompat.SignatureType) ([]
 	return loom.LocalAddressFromPublicKey(tx.PublicKey), nil
 }
 
+// verifySecp256r1 verifies a tx signed by a secp256r1 key, the signer's public key must be included
+// in the tx since it can't be recovered from the signature.
+func verifySecp256r1(chainID string, tx SignedTx, _ []evmcompat.SignatureType) ([]byte, error) {
+	if err := keys.VerifySecp256r1(tx.PublicKey, tx.Inner, tx.Signature); err != nil {
+		return nil, err
+	}
+	return keys.LocalAddressFromSecp256r1PubKey(tx.PublicKey)
+}
+
+// newCosmosOriginRecoveryFunc returns a function that verifies a tx signed by a Cosmos secp256k1
+// key, the signer's amino encoded public key must be included in the tx. Cosmos wallets sign the
+// tx as arbitrary data (ADR-036), with the signer address bech32 encoded using the given chain ID
+// as the prefix.
+func newCosmosOriginRecoveryFunc(bech32Prefix string) originRecoveryFunc {
+	return func(_ string, tx SignedTx, _ []evmcompat.SignatureType) ([]byte, error) {
+		if err := keys.VerifyCosmos(tx.PublicKey, bech32Prefix, tx.Inner, tx.Signature); err != nil {
+			return nil, err
+		}
+		return keys.LocalAddressFromCosmosPubKey(tx.PublicKey)
+	}
+}
+
 func getAllowedSignatureTypes(state loomchain.State, chainID string) []evmcompat.SignatureType {
 	if !state.FeatureEnabled(features.MultiChainSigTxMiddlewareVersion1_1, false) {
 		return []evmcompat.SignatureType{
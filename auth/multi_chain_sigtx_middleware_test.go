@@ -5,9 +5,15 @@ package auth
 import (
 	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -24,8 +30,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmsecp256k1 "github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/auth/keys"
 	"github.com/loomnetwork/loomchain/builtin/plugins/address_mapper"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/store"
@@ -301,6 +309,115 @@ func TestBinanceAddressMappingVerification(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSecp256r1AndCosmosAddressMappingVerification(t *testing.T) {
+	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: defaultLoomChainId}, nil, nil)
+	state.SetFeature(features.MultiChainSigTxMiddlewareVersion1_1, true)
+	state.SetFeature(features.AuthSigTxFeaturePrefix+"passkey", true)
+	state.SetFeature(features.AuthSigTxFeaturePrefix+"cosmos", true)
+	fakeCtx := goloomplugin.CreateFakeContext(addr1, addr1)
+	fakeCtx.SetFeature(features.AddressMapperVersion1_4, true)
+	addresMapperAddr := fakeCtx.CreateContract(address_mapper.Contract)
+	amCtx := contractpb.WrapPluginContext(fakeCtx.WithAddress(addresMapperAddr))
+
+	ctx := context.WithValue(state.Context(), ContextKeyOrigin, origin)
+
+	chains := map[string]ChainConfig{
+		"default": {
+			TxType:      LoomSignedTxType,
+			AccountType: NativeAccountType,
+		},
+		"passkey": {
+			TxType:      Secp256r1SignedTxType,
+			AccountType: MappedAccountType,
+		},
+		"cosmos": {
+			TxType:      CosmosSignedTxType,
+			AccountType: MappedAccountType,
+		},
+	}
+	tmx := NewMultiChainSignatureTxMiddleware(
+		chains,
+		func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil },
+	)
+
+	am := address_mapper.AddressMapper{}
+	require.NoError(t, am.Init(amCtx, &address_mapper.InitRequest{}))
+
+	// Generate a secp256r1 key
+	r1Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	r1PubKey := elliptic.Marshal(elliptic.P256(), r1Key.X, r1Key.Y)
+	r1LocalAddr, err := keys.LocalAddressFromSecp256r1PubKey(r1PubKey)
+	require.NoError(t, err)
+	r1Addr := loom.Address{ChainID: "passkey", Local: r1LocalAddr}
+
+	// Transaction from an unmapped secp256r1 account. Gives error.
+	nonceTx := mockNonceTx(t, r1Addr, sequence)
+	txSigned := mockPubKeySignedTx(t, nonceTx, r1PubKey, mockSecp256r1Sig(t, r1Key, nonceTx))
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.Error(t, err)
+
+	// Set up address mapping between the secp256r1 and local accounts (signature type 128)
+	sig, err := address_mapper.EncodeSigWithPubKey(
+		address_mapper.SignatureTypeSecp256r1, r1PubKey,
+		mockSecp256r1Sig(t, r1Key, address_mapper.IdentityMappingHash(addr1, r1Addr)),
+	)
+	require.NoError(t, err)
+	require.NoError(t, am.AddIdentityMapping(amCtx, &amtypes.AddressMapperAddIdentityMappingRequest{
+		From:      addr1.MarshalPB(),
+		To:        r1Addr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.NoError(t, err)
+
+	// A tx signed by a different secp256r1 key is rejected
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	txSigned = mockPubKeySignedTx(t, nonceTx, r1PubKey, mockSecp256r1Sig(t, otherKey, nonceTx))
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.Error(t, err)
+
+	// Generate a Cosmos key
+	cosmosKey := tmsecp256k1.GenPrivKey()
+	cosmosPubKey := cosmosKey.PubKey().Bytes()
+	cosmosAddr := loom.Address{ChainID: "cosmos", Local: loom.LocalAddress(cosmosKey.PubKey().Address())}
+	addr2 := loom.MustParseAddress(defaultLoomChainId + ":0x5BE813e5AA5ea26930edfc84ef12Cc164a652327")
+
+	// Set up address mapping between the Cosmos and local accounts (signature type 129)
+	signBytes, err := keys.CosmosSignBytes("cosmos", cosmosPubKey, address_mapper.IdentityMappingHash(addr2, cosmosAddr))
+	require.NoError(t, err)
+	cosmosSig, err := cosmosKey.Sign(signBytes)
+	require.NoError(t, err)
+	sig, err = address_mapper.EncodeSigWithPubKey(address_mapper.SignatureTypeCosmos, cosmosPubKey, cosmosSig)
+	require.NoError(t, err)
+	require.NoError(t, am.AddIdentityMapping(
+		contractpb.WrapPluginContext(fakeCtx.WithAddress(addresMapperAddr).WithSender(addr2)),
+		&amtypes.AddressMapperAddIdentityMappingRequest{
+			From:      addr2.MarshalPB(),
+			To:        cosmosAddr.MarshalPB(),
+			Signature: sig,
+		},
+	))
+
+	// A raw signature of the tx isn't accepted, Cosmos txs must be signed as ADR-036 sign docs
+	nonceTx = mockNonceTx(t, cosmosAddr, sequence)
+	rawSig, err := cosmosKey.Sign(nonceTx)
+	require.NoError(t, err)
+	txSigned = mockPubKeySignedTx(t, nonceTx, cosmosPubKey, rawSig)
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.Error(t, err)
+
+	signBytes, err = keys.CosmosSignBytes("cosmos", cosmosPubKey, nonceTx)
+	require.NoError(t, err)
+	cosmosSig, err = cosmosKey.Sign(signBytes)
+	require.NoError(t, err)
+	txSigned = mockPubKeySignedTx(t, nonceTx, cosmosPubKey, cosmosSig)
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.NoError(t, err)
+}
+
 func TestChainIdVerification(t *testing.T) {
 	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: defaultLoomChainId}, nil, nil)
 	state.SetFeature(features.AddressMapperVersion1_1, true)
@@ -377,6 +494,123 @@ func TestChainIdVerification(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSessionKeyVerification(t *testing.T) {
+	now := time.Now()
+	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{
+		ChainID: defaultLoomChainId,
+		Time:    now,
+	}, nil, nil)
+	state.SetFeature(features.MultiChainSigTxMiddlewareVersion1_1, true)
+	state.SetFeature(features.MultiChainSigTxMiddlewareVersion1_2, true)
+	state.SetFeature(features.AuthSigTxFeaturePrefix+"eth", true)
+
+	fakeCtx := goloomplugin.CreateFakeContext(addr1, addr1).
+		WithBlock(loom.BlockHeader{ChainID: defaultLoomChainId, Time: now.Unix()})
+	fakeCtx.SetFeature(features.AddressMapperVersion1_5, true)
+	addresMapperAddr := fakeCtx.CreateContract(address_mapper.Contract)
+	amCtx := contractpb.WrapPluginContext(fakeCtx.WithAddress(addresMapperAddr))
+
+	ctx := context.WithValue(state.Context(), ContextKeyOrigin, origin)
+
+	chains := map[string]ChainConfig{
+		"default": {
+			TxType:      LoomSignedTxType,
+			AccountType: NativeAccountType,
+		},
+		"eth": {
+			TxType:      EthereumSignedTxType,
+			AccountType: MappedAccountType,
+		},
+	}
+	tmx := NewMultiChainSignatureTxMiddleware(
+		chains,
+		func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil },
+	)
+
+	am := address_mapper.AddressMapper{}
+	require.NoError(t, am.Init(amCtx, &address_mapper.InitRequest{}))
+
+	sessionKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sessionKeyLocalAddr, err := loom.LocalAddressFromHexString(crypto.PubkeyToAddress(sessionKey.PublicKey).Hex())
+	require.NoError(t, err)
+	sessionKeyAddr := loom.Address{ChainID: "eth", Local: sessionKeyLocalAddr}
+
+	// The session key isn't mapped to any account, and hasn't been authorized yet
+	txSigned := mockSignedTx(t, "eth", &auth.EthSigner66Byte{PrivateKey: sessionKey})
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.Error(t, err)
+
+	sig, err := evmcompat.GenerateTypedSig(
+		address_mapper.SessionKeyAuthHash(addr1, sessionKeyAddr), sessionKey, evmcompat.SignatureType_EIP712,
+	)
+	require.NoError(t, err)
+	req := &address_mapper.AddSessionKeyRequest{
+		SessionKey:       sessionKeyAddr.MarshalPB(),
+		ExpiresAt:        uint64(now.Add(time.Hour).Unix()),
+		AllowedContracts: []*types.Address{contract.MarshalPB()},
+		// selector of the mock call tx
+		AllowedMethods: []string{"0x" + hex.EncodeToString([]byte("orig"))},
+		TxBudget:       1,
+		Signature:      sig,
+	}
+	require.NoError(t, am.AddSessionKey(amCtx, req))
+
+	// The tx origin & sender should resolve to the account that authorized the session key
+	_, err = tmx.ProcessTx(state.WithContext(ctx), txSigned,
+		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+			require.Equal(t, 0, Origin(state.Context()).Compare(addr1))
+			return loomchain.TxHandlerResult{}, nil
+		}, false,
+	)
+	require.NoError(t, err)
+
+	// The session key has used up its tx budget
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.Error(t, err)
+
+	// Re-authorizing the session key resets the tx budget
+	amCtx = contractpb.WrapPluginContext(fakeCtx.WithBlock(loom.BlockHeader{
+		ChainID: defaultLoomChainId,
+		Time:    now.Add(time.Minute).Unix(),
+	}).WithAddress(addresMapperAddr))
+	require.NoError(t, am.AddSessionKey(amCtx, req))
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.NoError(t, err)
+
+	// Only the allowed methods can be called
+	req.AllowedMethods = []string{"0xa9059cbb"}
+	req.TxBudget = 0
+	require.NoError(t, am.AddSessionKey(amCtx, req))
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.Error(t, err)
+
+	// Session keys can't be allowed to call the Address Mapper
+	req.AllowedMethods = nil
+	req.AllowedContracts = []*types.Address{contract.MarshalPB(), addresMapperAddr.MarshalPB()}
+	require.Error(t, am.AddSessionKey(amCtx, req))
+	req.AllowedContracts = []*types.Address{contract.MarshalPB()}
+	require.NoError(t, am.AddSessionKey(amCtx, req))
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.NoError(t, err)
+	// and txs signed by session keys that call the Address Mapper are rejected
+	signedTx := auth.SignTx(
+		&auth.EthSigner66Byte{PrivateKey: sessionKey},
+		mockNonceTxTo(t, sessionKeyAddr, addresMapperAddr, sequence),
+	)
+	mapperTxSigned, err := proto.Marshal(signedTx)
+	require.NoError(t, err)
+	_, err = throttleMiddlewareHandler(tmx, state, mapperTxSigned, ctx)
+	require.Equal(t, ErrSessionKeyNotAllowed, errors.Cause(err))
+
+	// Once the session key is revoked it can no longer be used
+	require.NoError(t, am.RemoveSessionKey(amCtx, &address_mapper.RemoveSessionKeyRequest{
+		SessionKey: sessionKeyAddr.MarshalPB(),
+	}))
+	_, err = throttleMiddlewareHandler(tmx, state, txSigned, ctx)
+	require.Error(t, err)
+}
+
 func throttleMiddlewareHandler(ttm loomchain.TxMiddlewareFunc, state loomchain.State, signedTx []byte, ctx context.Context) (loomchain.TxHandlerResult, error) {
 	return ttm.ProcessTx(state.WithContext(ctx), signedTx,
 		func(state loomchain.State, txBytes []byte, isCheckTx bool) (res loomchain.TxHandlerResult, err error) {
@@ -446,7 +680,34 @@ func mockBinanceSignedTx(t *testing.T, chainID string, signer auth.Signer) []byt
 	return marshalledSignedTx
 }
 
+// mockPubKeySignedTx wraps a NonceTx in a SignedTx that includes the signer's public key.
+func mockPubKeySignedTx(t *testing.T, nonceTx []byte, pubKey []byte, sig []byte) []byte {
+	marshalledSignedTx, err := proto.Marshal(&auth.SignedTx{
+		Inner:     nonceTx,
+		Signature: sig,
+		PublicKey: pubKey,
+	})
+	require.NoError(t, err)
+	return marshalledSignedTx
+}
+
+// mockSecp256r1Sig returns a raw 64-byte (R || S) secp256r1 signature of the SHA-256 hash of msg.
+func mockSecp256r1Sig(t *testing.T, key *ecdsa.PrivateKey, msg []byte) []byte {
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	sig := make([]byte, keys.Secp256r1SignatureSize)
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rBytes):32], rBytes)
+	copy(sig[64-len(sBytes):], sBytes)
+	return sig
+}
+
 func mockNonceTx(t *testing.T, from loom.Address, sequence uint64) []byte {
+	return mockNonceTxTo(t, from, contract, sequence)
+}
+
+func mockNonceTxTo(t *testing.T, from, to loom.Address, sequence uint64) []byte {
 	origBytes := []byte("origin")
 	callTx, err := proto.Marshal(&vm.CallTx{
 		VmType: vm.VMType_EVM,
@@ -455,7 +716,7 @@ func mockNonceTx(t *testing.T, from loom.Address, sequence uint64) []byte {
 	require.NoError(t, err)
 	messageTx, err := proto.Marshal(&vm.MessageTx{
 		Data: callTx,
-		To:   contract.MarshalPB(),
+		To:   to.MarshalPB(),
 		From: from.MarshalPB(),
 	})
 	require.NoError(t, err)
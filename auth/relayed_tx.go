@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/loomnetwork/loomchain"
+	"github.com/pkg/errors"
+)
+
+// RelayedTxID identifies a relayed tx, i.e. a tx signed by a relayer that wraps a tx signed by a
+// user. The relayer submits the tx & pays for it (karma, rate limits, etc.) while the user remains
+// the tx origin.
+//
+// A relayed tx is encoded in the same way as a regular tx:
+// SignedTx (relayer) -> NonceTx -> Transaction (RelayedTxID) -> MessageTx (from relayer)
+// but instead of a CallTx or DeployTx the MessageTx data contains the user's SignedTx. The sequence
+// number in the relayer's NonceTx is ignored, replay protection is provided by the nonce in the
+// user's NonceTx.
+const RelayedTxID types.TxID = 5
+
+// NewRelayedTxMiddleware returns middleware that verifies the signature of a tx using the given
+// signature middleware, and if the tx is a relayed tx also verifies the signature of the user tx
+// wrapped within it. For relayed txs the user tx is passed on to the next middleware, with the tx
+// origin set to the user and the relayer stored in the context (see Payer), so NonceHandler checks
+// the nonce of the user rather than the relayer. Other txs are passed through as is.
+func NewRelayedTxMiddleware(sigTxMiddleware loomchain.TxMiddlewareFunc) loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (loomchain.TxHandlerResult, error) {
+		return sigTxMiddleware(state, txBytes, func(
+			state loomchain.State, nonceTxBytes []byte, isCheckTx bool,
+		) (loomchain.TxHandlerResult, error) {
+			var r loomchain.TxHandlerResult
+
+			userSignedTxBytes, isRelayed, err := unwrapRelayedTx(nonceTxBytes)
+			if err != nil {
+				return r, err
+			}
+			if !isRelayed {
+				return next(state, nonceTxBytes, isCheckTx)
+			}
+
+			relayer := Origin(state.Context())
+			return sigTxMiddleware(state, userSignedTxBytes, func(
+				state loomchain.State, userNonceTxBytes []byte, isCheckTx bool,
+			) (loomchain.TxHandlerResult, error) {
+				if _, isRelayed, _ := unwrapRelayedTx(userNonceTxBytes); isRelayed {
+					return r, errors.New("relayed txs can't be nested")
+				}
+				ctx := context.WithValue(state.Context(), ContextKeyRelayer, relayer)
+				return next(state.WithContext(ctx), userNonceTxBytes, isCheckTx)
+			}, isCheckTx)
+		}, isCheckTx)
+	})
+}
+
+// unwrapRelayedTx returns the user SignedTx wrapped within the given relayer NonceTx, and false if
+// the NonceTx doesn't contain a relayed tx.
+func unwrapRelayedTx(nonceTxBytes []byte) ([]byte, bool, error) {
+	var nonceTx NonceTx
+	if err := proto.Unmarshal(nonceTxBytes, &nonceTx); err != nil {
+		return nil, false, nil // not a relayed tx, let the next middleware deal with it
+	}
+
+	var tx types.Transaction
+	if err := proto.Unmarshal(nonceTx.Inner, &tx); err != nil {
+		return nil, false, nil
+	}
+	if types.TxID(tx.Id) != RelayedTxID {
+		return nil, false, nil
+	}
+
+	var msg vm.MessageTx
+	if err := proto.Unmarshal(tx.Data, &msg); err != nil {
+		return nil, false, errors.Wrap(err, "failed to unmarshal relayed MessageTx")
+	}
+	if len(msg.Data) == 0 {
+		return nil, false, errors.New("malformed relayed tx, user tx not specified")
+	}
+	return msg.Data, true, nil
+}
+
+// NewRelayedTx wraps a tx signed by a user into a relayed tx, the returned NonceTx must be signed
+// by the relayer.
+func NewRelayedTx(relayer loom.Address, userSignedTx []byte) ([]byte, error) {
+	msgTxBytes, err := proto.Marshal(&vm.MessageTx{
+		From: relayer.MarshalPB(),
+		Data: userSignedTx,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal MessageTx")
+	}
+	txBytes, err := proto.Marshal(&types.Transaction{
+		Id:   uint32(RelayedTxID),
+		Data: msgTxBytes,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal Transaction")
+	}
+	return proto.Marshal(&NonceTx{Inner: txBytes})
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/auth"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/store"
+)
+
+func TestRelayedTxMiddleware(t *testing.T) {
+	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: "default"}, nil, nil)
+	mw := NewRelayedTxMiddleware(SignatureTxMiddleware)
+
+	_, userPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	userSigner := auth.NewEd25519Signer([]byte(userPrivKey))
+	userAddr := loom.Address{ChainID: "default", Local: loom.LocalAddressFromPublicKey(userSigner.PublicKey())}
+
+	_, relayerPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	relayerSigner := auth.NewEd25519Signer([]byte(relayerPrivKey))
+	relayerAddr := loom.Address{
+		ChainID: "default",
+		Local:   loom.LocalAddressFromPublicKey(relayerSigner.PublicKey()),
+	}
+
+	userNonceTxBytes, err := proto.Marshal(&NonceTx{Inner: []byte("hello"), Sequence: 3})
+	require.NoError(t, err)
+	userSignedTxBytes, err := proto.Marshal(auth.SignTx(userSigner, userNonceTxBytes))
+	require.NoError(t, err)
+
+	// Regular txs are passed through as is
+	_, err = mw.ProcessTx(state, userSignedTxBytes,
+		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+			require.Equal(t, userNonceTxBytes, txBytes)
+			require.Equal(t, 0, Origin(state.Context()).Compare(userAddr))
+			require.Equal(t, 0, Payer(state.Context()).Compare(userAddr))
+			return loomchain.TxHandlerResult{}, nil
+		}, false,
+	)
+	require.NoError(t, err)
+
+	// Relayed txs are unwrapped, the user remains the origin, and the relayer pays for the tx
+	relayedNonceTxBytes, err := NewRelayedTx(relayerAddr, userSignedTxBytes)
+	require.NoError(t, err)
+	relayedSignedTxBytes, err := proto.Marshal(auth.SignTx(relayerSigner, relayedNonceTxBytes))
+	require.NoError(t, err)
+	_, err = mw.ProcessTx(state, relayedSignedTxBytes,
+		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+			require.Equal(t, userNonceTxBytes, txBytes)
+			require.Equal(t, 0, Origin(state.Context()).Compare(userAddr))
+			require.Equal(t, 0, Payer(state.Context()).Compare(relayerAddr))
+			return loomchain.TxHandlerResult{}, nil
+		}, false,
+	)
+	require.NoError(t, err)
+
+	// The user tx must be correctly signed
+	userSignedTx := auth.SignTx(userSigner, userNonceTxBytes)
+	userSignedTx.Inner = []byte("tampered")
+	tamperedTxBytes, err := proto.Marshal(userSignedTx)
+	require.NoError(t, err)
+	relayedNonceTxBytes, err = NewRelayedTx(relayerAddr, tamperedTxBytes)
+	require.NoError(t, err)
+	relayedSignedTxBytes, err = proto.Marshal(auth.SignTx(relayerSigner, relayedNonceTxBytes))
+	require.NoError(t, err)
+	_, err = mw.ProcessTx(state, relayedSignedTxBytes,
+		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+			return loomchain.TxHandlerResult{}, nil
+		}, false,
+	)
+	require.Error(t, err)
+
+	// Relayed txs can't be nested
+	relayedNonceTxBytes, err = NewRelayedTx(relayerAddr, userSignedTxBytes)
+	require.NoError(t, err)
+	innerRelayedTxBytes, err := proto.Marshal(auth.SignTx(userSigner, relayedNonceTxBytes))
+	require.NoError(t, err)
+	relayedNonceTxBytes, err = NewRelayedTx(relayerAddr, innerRelayedTxBytes)
+	require.NoError(t, err)
+	relayedSignedTxBytes, err = proto.Marshal(auth.SignTx(relayerSigner, relayedNonceTxBytes))
+	require.NoError(t, err)
+	_, err = mw.ProcessTx(state, relayedSignedTxBytes,
+		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+			return loomchain.TxHandlerResult{}, nil
+		}, false,
+	)
+	require.Error(t, err)
+}
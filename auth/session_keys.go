@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/builtin/plugins/address_mapper"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSessionKeyExpired         = errors.New("session key expired")
+	ErrSessionKeyNotAllowed      = errors.New("session key not allowed to call contract method")
+	ErrSessionKeyBudgetExhausted = errors.New("session key tx budget exhausted")
+)
+
+func sessionKeyTxCountKey(sessionKey loom.Address, createdAt uint64) []byte {
+	// The tx count is keyed by the time the session key was authorized so that it's reset whenever
+	// the session key is re-authorized.
+	createdAtBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(createdAtBytes, createdAt)
+	return util.PrefixKey([]byte("session-key-txs"), sessionKey.Bytes(), createdAtBytes)
+}
+
+// resolveSessionKey checks if the tx signer is a session key authorized by another account, and if
+// so returns the account that authorized it (the owner). Returns false if the signer isn't a session
+// key, and an error if the tx isn't permitted by the session key authorization.
+// Each tx signed by a session key with a limited tx budget is counted against the budget.
+func resolveSessionKey(
+	state loomchain.State,
+	signer loom.Address,
+	tx *types.Transaction,
+	msg *vm.MessageTx,
+	createAddressMapperCtx func(state loomchain.State) (contractpb.StaticContext, error),
+) (loom.Address, bool, error) {
+	ctx, err := createAddressMapperCtx(state)
+	if err != nil {
+		return loom.Address{}, false, errors.Wrap(err, "failed to create Address Mapper context")
+	}
+
+	sessionKey, err := address_mapper.LoadSessionKey(ctx, signer)
+	if err != nil {
+		return loom.Address{}, false, err
+	}
+	if sessionKey == nil {
+		return loom.Address{}, false, nil
+	}
+
+	if uint64(state.Block().Time) >= sessionKey.ExpiresAt {
+		return loom.Address{}, false, ErrSessionKeyExpired
+	}
+
+	// Session keys can only be used to call contracts, not to deploy them.
+	if types.TxID(tx.Id) != types.TxID_CALL || msg.To == nil {
+		return loom.Address{}, false, ErrSessionKeyNotAllowed
+	}
+	contractAddr := loom.UnmarshalAddressPB(msg.To)
+	// Session keys must never be able to manage mappings or other session keys of the owner, even
+	// if the authorization was stored before AddSessionKey started rejecting the Address Mapper.
+	if contractAddr.Compare(ctx.ContractAddress()) == 0 {
+		return loom.Address{}, false, ErrSessionKeyNotAllowed
+	}
+	if !isSessionKeyContractAllowed(sessionKey, contractAddr) {
+		return loom.Address{}, false, ErrSessionKeyNotAllowed
+	}
+	if len(sessionKey.AllowedMethods) > 0 {
+		method, err := getCallTxMethod(msg)
+		if err != nil {
+			return loom.Address{}, false, err
+		}
+		if !isSessionKeyMethodAllowed(sessionKey, method) {
+			return loom.Address{}, false, ErrSessionKeyNotAllowed
+		}
+	}
+
+	if sessionKey.TxBudget > 0 {
+		seq := loomchain.NewSequence(sessionKeyTxCountKey(signer, sessionKey.CreatedAt))
+		if seq.Value(state) >= sessionKey.TxBudget {
+			return loom.Address{}, false, ErrSessionKeyBudgetExhausted
+		}
+		seq.Next(state)
+	}
+
+	return loom.UnmarshalAddressPB(sessionKey.Owner), true, nil
+}
+
+func isSessionKeyContractAllowed(sessionKey *address_mapper.SessionKey, contractAddr loom.Address) bool {
+	for _, addr := range sessionKey.AllowedContracts {
+		if loom.UnmarshalAddressPB(addr).Compare(contractAddr) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isSessionKeyMethodAllowed(sessionKey *address_mapper.SessionKey, method string) bool {
+	for _, allowedMethod := range sessionKey.AllowedMethods {
+		if allowedMethod == method {
+			return true
+		}
+	}
+	return false
+}
+
+// getCallTxMethod returns the name of the Go contract method, or the hex encoded 4-byte selector of
+// the EVM contract method, called by the given tx.
+func getCallTxMethod(msg *vm.MessageTx) (string, error) {
+	var callTx vm.CallTx
+	if err := proto.Unmarshal(msg.Data, &callTx); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal CallTx")
+	}
+
+	switch callTx.VmType {
+	case vm.VMType_PLUGIN:
+		var req plugin.Request
+		if err := proto.Unmarshal(callTx.Input, &req); err != nil {
+			return "", errors.Wrap(err, "failed to unmarshal Request")
+		}
+		unmarshaler, err := contractpb.UnmarshalerFactory(req.ContentType)
+		if err != nil {
+			return "", err
+		}
+		var methodCall plugin.ContractMethodCall
+		if err := unmarshaler.Unmarshal(bytes.NewBuffer(req.Body), &methodCall); err != nil {
+			return "", errors.Wrap(err, "failed to unmarshal ContractMethodCall")
+		}
+		return methodCall.Method, nil
+	case vm.VMType_EVM:
+		if len(callTx.Input) < 4 {
+			return "", nil
+		}
+		return "0x" + hex.EncodeToString(callTx.Input[:4]), nil
+	}
+	return "", fmt.Errorf("unsupported VM type %v", callTx.VmType)
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/loomnetwork/loomchain"
+	"github.com/pkg/errors"
+)
+
+// ErrTxExpired is returned for txs that can no longer be executed because the block height or time
+// specified in the ExpiringNonceTx has passed. In CheckTx this causes the tx to be evicted from the
+// mempool when the mempool is rechecked after each block.
+var ErrTxExpired = errors.New("tx expired")
+
+// checkTxExpiry returns ErrTxExpired if the given NonceTx is an ExpiringNonceTx that can no longer be
+// executed. In CheckTx the block header is that of the last committed block, so a tx that can't be
+// included in the next block is treated as expired.
+func checkTxExpiry(state loomchain.State, nonceTxBytes []byte, isCheckTx bool) error {
+	var tx ExpiringNonceTx
+	if err := proto.Unmarshal(nonceTxBytes, &tx); err != nil {
+		return errors.Wrap(err, "failed to unmarshal ExpiringNonceTx")
+	}
+
+	block := state.Block()
+	height := uint64(block.Height)
+	if isCheckTx {
+		height++
+	}
+	if tx.ValidUntilHeight != 0 && height > tx.ValidUntilHeight {
+		return errors.Wrapf(ErrTxExpired, "valid until height %d, current height %d",
+			tx.ValidUntilHeight, block.Height,
+		)
+	}
+	if tx.ValidUntilTime != 0 && block.Time > tx.ValidUntilTime {
+		return errors.Wrapf(ErrTxExpired, "valid until time %d, current block time %d",
+			tx.ValidUntilTime, block.Time,
+		)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"crypto/ecdsa"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
@@ -15,9 +16,11 @@ import (
 	"github.com/loomnetwork/go-loom/plugin"
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/auth/keys"
 	"github.com/loomnetwork/loomchain/features"
 	ssha "github.com/miguelmota/go-solidity-sha3"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
 )
 
 type (
@@ -25,7 +28,6 @@ type (
 
 	InitRequest               = amtypes.AddressMapperInitRequest
 	AddIdentityMappingRequest = amtypes.AddressMapperAddIdentityMappingRequest
-	RemoveMappingRequest      = amtypes.AddressMapperRemoveMappingRequest
 	GetMappingRequest         = amtypes.AddressMapperGetMappingRequest
 	GetMappingResponse        = amtypes.AddressMapperGetMappingResponse
 
@@ -36,6 +38,25 @@ type (
 	ListMappingResponse = amtypes.AddressMapperListMappingResponse
 )
 
+const (
+	MappingRemovedEventTopic = "addressmapper:mappingremoved"
+	MappingReboundEventTopic = "addressmapper:mappingrebound"
+
+	// Signature types for keys that aren't supported by evmcompat typed signatures. Since the
+	// signer's public key can't be recovered from these signatures it must be included in the
+	// signature: sig type (1 byte) | public key length (1 byte) | public key | signature.
+	// The signature of a secp256r1 key can also be a JSON encoded WebAuthn assertion.
+	SignatureTypeSecp256r1 evmcompat.SignatureType = 128
+	SignatureTypeCosmos    evmcompat.SignatureType = 129
+	SignatureTypeEd25519   evmcompat.SignatureType = 130
+
+	// MappingChangeCooldown is the minimum amount of time that must pass after the mapping of an
+	// account is removed or rebound before the mapping of that account can be changed again.
+	MappingChangeCooldown = 24 * time.Hour
+
+	mappingRemovalHashTypeName = "LoomRemoveMapping"
+)
+
 var (
 	// ErrrNotAuthorized indicates that a contract method failed because the caller didn't have
 	// the permission to execute that method.
@@ -46,14 +67,31 @@ var (
 	// ErrAlreadyRegistered indicates that from and/or to are already registered in
 	// address mapper contract.
 	ErrAlreadyRegistered = errors.New("[Address Mapper] identity mapping already exists")
+	// ErrMappingNotFound indicates that the account isn't mapped to any other account.
+	ErrMappingNotFound = errors.New("[Address Mapper] identity mapping not found")
+	// ErrMappingCooldown indicates that the mapping of an account was changed too recently.
+	ErrMappingCooldown = errors.New("[Address Mapper] identity mapping was changed too recently")
+
+	errMappingChangesDisabled = errors.New("[Address Mapper] identity mapping changes are not enabled")
+	errMappingIndexDisabled   = errors.New("[Address Mapper] mapping index is not enabled")
 
-	AddressPrefix = "addr"
+	AddressPrefix       = "addr"
+	MappingChangePrefix = "mapping-change"
+	RemovalNoncePrefix  = "removal-nonce"
 )
 
 func addressKey(addr loom.Address) []byte {
 	return util.PrefixKey([]byte(AddressPrefix), addr.Bytes())
 }
 
+func mappingChangeKey(addr loom.Address) []byte {
+	return util.PrefixKey([]byte(MappingChangePrefix), addr.Bytes())
+}
+
+func removalNonceKey(addr loom.Address) []byte {
+	return util.PrefixKey([]byte(RemovalNoncePrefix), addr.Bytes())
+}
+
 type AddressMapper struct {
 }
 
@@ -83,27 +121,8 @@ func (am *AddressMapper) AddIdentityMapping(ctx contract.Context, req *AddIdenti
 		return ErrInvalidRequest
 	}
 
-	allowedSigTypes := []evmcompat.SignatureType{
-		evmcompat.SignatureType_EIP712,
-		evmcompat.SignatureType_GETH,
-		evmcompat.SignatureType_TREZOR,
-		evmcompat.SignatureType_TRON,
-	}
-	if ctx.FeatureEnabled(features.AddressMapperVersion1_1, false) {
-		allowedSigTypes = append(allowedSigTypes, evmcompat.SignatureType_BINANCE)
-	}
-
-	callerAddr := ctx.Message().Sender
-	if callerAddr.Compare(from) == 0 {
-		if err := verifySig(from, to, to.ChainID, req.Signature, allowedSigTypes); err != nil {
-			return errors.Wrap(err, ErrNotAuthorized.Error())
-		}
-	} else if callerAddr.Compare(to) == 0 {
-		if err := verifySig(from, to, from.ChainID, req.Signature, allowedSigTypes); err != nil {
-			return errors.Wrap(err, ErrNotAuthorized.Error())
-		}
-	} else {
-		return ErrInvalidRequest
+	if _, err := verifyCallerAndSig(ctx, from, to, req.Signature); err != nil {
+		return err
 	}
 
 	var existingMapping AddressMapping
@@ -120,26 +139,167 @@ func (am *AddressMapper) AddIdentityMapping(ctx contract.Context, req *AddIdenti
 		return err
 	}
 
-	err := ctx.Set(addressKey(from), &AddressMapping{
-		From: req.From,
-		To:   req.To,
-	})
+	if ctx.FeatureEnabled(features.AddressMapperVersion1_2, false) {
+		// Prevent the cooldown from being bypassed by removing a mapping and adding a new one
+		if err := checkMappingCooldown(ctx, from, to); err != nil {
+			return err
+		}
+	}
+
+	return setMapping(ctx, from, to)
+}
+
+// RemoveMapping removes the mapping between the caller's account and the account it's mapped to.
+// The caller can be either one of the mapped accounts, so a user that lost the key of one of the
+// accounts can still remove the mapping using the other account. The caller's account must sign
+// MappingRemovalHash(from, to), so the mapping can't be removed by a session key signing txs on
+// behalf of the caller. The hash includes the removal nonce of the caller's account, which is
+// incremented by every removal, so a removal signature can't be replayed once the accounts are
+// mapped again. The mapping of the caller's account can't be removed within MappingChangeCooldown
+// of the last time it was changed.
+func (am *AddressMapper) RemoveMapping(ctx contract.Context, req *RemoveMappingRequest) error {
+	if !ctx.FeatureEnabled(features.AddressMapperVersion1_2, false) {
+		return errMappingChangesDisabled
+	}
+	if req.From == nil || req.Signature == nil {
+		return ErrInvalidRequest
+	}
+	from := loom.UnmarshalAddressPB(req.From)
+	if ctx.Message().Sender.Compare(from) != 0 {
+		return ErrNotAuthorized
+	}
+
+	mapping, err := loadMapping(ctx, from)
 	if err != nil {
 		return err
 	}
-	err = ctx.Set(addressKey(to), &AddressMapping{
-		From: req.To,
-		To:   req.From,
-	})
+	to := loom.UnmarshalAddressPB(mapping.To)
+	if err := verifyRemovalSig(ctx, from, to, req.Signature); err != nil {
+		return err
+	}
+	if err := checkMappingCooldown(ctx, from, to); err != nil {
+		return err
+	}
+
+	ctx.Delete(addressKey(from))
+	ctx.Delete(addressKey(to))
+	if ctx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		if err := unindexMapping(ctx, from, to); err != nil {
+			return err
+		}
+	}
+	if err := recordMappingChange(ctx, from, to); err != nil {
+		return err
+	}
+	if err := incrementRemovalNonce(ctx, from); err != nil {
+		return err
+	}
+
+	return emitEvent(ctx, &MappingRemovedEvent{
+		From: from.MarshalPB(),
+		To:   to.MarshalPB(),
+	}, MappingRemovedEventTopic)
+}
+
+// RebindMapping replaces the account the caller's account is mapped to with another account, the
+// caller must provide proof of ownership of the new account in the same way as for
+// AddIdentityMapping. The new account must not be mapped to any other account, and the mapping of
+// the caller's account can't be changed within MappingChangeCooldown of the last time it was
+// changed.
+func (am *AddressMapper) RebindMapping(ctx contract.Context, req *RebindMappingRequest) error {
+	if !ctx.FeatureEnabled(features.AddressMapperVersion1_2, false) {
+		return errMappingChangesDisabled
+	}
+	if req.From == nil || req.To == nil || req.Signature == nil {
+		return ErrInvalidRequest
+	}
+	from := loom.UnmarshalAddressPB(req.From)
+	to := loom.UnmarshalAddressPB(req.To)
+	if from.ChainID == "" || to.ChainID == "" {
+		return ErrInvalidRequest
+	}
+	if from.Compare(to) == 0 {
+		return ErrInvalidRequest
+	}
+
+	newMappedAddr, err := verifyCallerAndSig(ctx, from, to, req.Signature)
 	if err != nil {
 		return err
 	}
-	return nil
+	callerAddr := ctx.Message().Sender
+
+	mapping, err := loadMapping(ctx, callerAddr)
+	if err != nil {
+		return err
+	}
+	oldMappedAddr := loom.UnmarshalAddressPB(mapping.To)
+	if oldMappedAddr.Compare(newMappedAddr) == 0 {
+		return ErrAlreadyRegistered
+	}
+
+	var existingMapping AddressMapping
+	if err := ctx.Get(addressKey(newMappedAddr), &existingMapping); err != contract.ErrNotFound {
+		if err == nil {
+			return ErrAlreadyRegistered
+		}
+		return err
+	}
+
+	if err := checkMappingCooldown(ctx, callerAddr, oldMappedAddr, newMappedAddr); err != nil {
+		return err
+	}
+
+	ctx.Delete(addressKey(oldMappedAddr))
+	if ctx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		if err := unindexMapping(ctx, callerAddr, oldMappedAddr); err != nil {
+			return err
+		}
+	}
+	if err := setMapping(ctx, callerAddr, newMappedAddr); err != nil {
+		return err
+	}
+	if err := recordMappingChange(ctx, callerAddr, oldMappedAddr, newMappedAddr); err != nil {
+		return err
+	}
+
+	return emitEvent(ctx, &MappingReboundEvent{
+		Account:          callerAddr.MarshalPB(),
+		OldMappedAccount: oldMappedAddr.MarshalPB(),
+		NewMappedAccount: newMappedAddr.MarshalPB(),
+	}, MappingReboundEventTopic)
 }
 
-func (am *AddressMapper) RemoveMapping(ctx contract.StaticContext, req *RemoveMappingRequest) error {
-	// TODO
-	return nil
+// GetMappingCooldown returns the time at which the mapping of the given account can be changed
+// again.
+func (am *AddressMapper) GetMappingCooldown(
+	ctx contract.StaticContext, req *GetMappingCooldownRequest,
+) (*GetMappingCooldownResponse, error) {
+	if req.Account == nil {
+		return nil, ErrInvalidRequest
+	}
+	endsAt, err := mappingCooldownEnd(ctx, loom.UnmarshalAddressPB(req.Account))
+	if err != nil {
+		return nil, err
+	}
+	if endsAt <= ctx.Now().Unix() {
+		endsAt = 0
+	}
+	return &GetMappingCooldownResponse{EndsAt: endsAt}, nil
+}
+
+// GetMappingRemovalNonce returns the nonce the given account must include in the hash it signs to
+// remove its mapping.
+func (am *AddressMapper) GetMappingRemovalNonce(
+	ctx contract.StaticContext, req *GetMappingRemovalNonceRequest,
+) (*GetMappingRemovalNonceResponse, error) {
+	if req.Account == nil {
+		return nil, ErrInvalidRequest
+	}
+	nonce, err := loadRemovalNonce(ctx, loom.UnmarshalAddressPB(req.Account))
+	if err != nil {
+		return nil, err
+	}
+	return &GetMappingRemovalNonceResponse{Nonce: nonce}, nil
 }
 
 func (am *AddressMapper) ListMapping(ctx contract.StaticContext, req *ListMappingRequest) (*ListMappingResponse, error) {
@@ -166,6 +326,29 @@ func (am *AddressMapper) ListMapping(ctx contract.StaticContext, req *ListMappin
 	return &listMappingResponse, nil
 }
 
+// ListMappingPage returns a page of mappings, optionally limited to mappings to accounts on a
+// specific foreign chain. Unlike ListMapping the mappings are loaded from the mapping index, so
+// only mappings that have been indexed will be returned.
+func (am *AddressMapper) ListMappingPage(
+	ctx contract.StaticContext, req *ListMappingPageRequest,
+) (*ListMappingPageResponse, error) {
+	if !ctx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		return nil, errMappingIndexDisabled
+	}
+	return ListMappingPage(ctx, req)
+}
+
+// GetMappingCount returns the number of indexed mappings, optionally limited to mappings to
+// accounts on a specific foreign chain.
+func (am *AddressMapper) GetMappingCount(
+	ctx contract.StaticContext, req *GetMappingCountRequest,
+) (*GetMappingCountResponse, error) {
+	if !ctx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		return nil, errMappingIndexDisabled
+	}
+	return GetMappingCount(ctx, req)
+}
+
 func (am *AddressMapper) HasMapping(ctx contract.StaticContext, req *HasMappingRequest) (*HasMappingResponse, error) {
 	if req.From == nil {
 		return nil, ErrInvalidRequest
@@ -201,15 +384,181 @@ func (am *AddressMapper) GetMapping(ctx contract.StaticContext, req *GetMappingR
 	}, nil
 }
 
+// verifyCallerAndSig checks that the caller is one of the given accounts, and that the signature
+// was produced by the other account. Returns the account that produced the signature.
+func verifyCallerAndSig(ctx contract.StaticContext, from, to loom.Address, sig []byte) (loom.Address, error) {
+	allowedSigTypes := getAllowedSignatureTypes(ctx)
+	callerAddr := ctx.Message().Sender
+	if callerAddr.Compare(from) == 0 {
+		if err := verifySig(from, to, to.ChainID, sig, allowedSigTypes); err != nil {
+			return loom.Address{}, errors.Wrap(err, ErrNotAuthorized.Error())
+		}
+		return to, nil
+	} else if callerAddr.Compare(to) == 0 {
+		if err := verifySig(from, to, from.ChainID, sig, allowedSigTypes); err != nil {
+			return loom.Address{}, errors.Wrap(err, ErrNotAuthorized.Error())
+		}
+		return from, nil
+	}
+	return loom.Address{}, ErrInvalidRequest
+}
+
+// verifyRemovalSig checks that the removal of the mapping between the given accounts was signed by
+// the from account. Local accounts sign with ed25519 keys, so ed25519 signatures are always
+// accepted, even if they can't be used to add a mapping yet.
+func verifyRemovalSig(ctx contract.StaticContext, from, to loom.Address, sig []byte) error {
+	allowedSigTypes := getAllowedSignatureTypes(ctx)
+	if !ctx.FeatureEnabled(features.AddressMapperVersion1_5, false) {
+		allowedSigTypes = append(allowedSigTypes, SignatureTypeEd25519)
+	}
+	nonce, err := loadRemovalNonce(ctx, from)
+	if err != nil {
+		return err
+	}
+	signerAddr, err := recoverSignerAddress(
+		from.ChainID, MappingRemovalHash(from, to, nonce), sig, allowedSigTypes,
+	)
+	if err != nil {
+		return errors.Wrap(err, ErrNotAuthorized.Error())
+	}
+	if !bytes.Equal(signerAddr, from.Local) {
+		return errors.Wrapf(
+			ErrNotAuthorized, "signer address doesn't match, %s != %s",
+			signerAddr.String(), from.Local.String(),
+		)
+	}
+	return nil
+}
+
+func getAllowedSignatureTypes(ctx contract.StaticContext) []evmcompat.SignatureType {
+	allowedSigTypes := []evmcompat.SignatureType{
+		evmcompat.SignatureType_EIP712,
+		evmcompat.SignatureType_GETH,
+		evmcompat.SignatureType_TREZOR,
+		evmcompat.SignatureType_TRON,
+	}
+	if ctx.FeatureEnabled(features.AddressMapperVersion1_1, false) {
+		allowedSigTypes = append(allowedSigTypes, evmcompat.SignatureType_BINANCE)
+	}
+	if ctx.FeatureEnabled(features.AddressMapperVersion1_4, false) {
+		allowedSigTypes = append(allowedSigTypes, SignatureTypeSecp256r1, SignatureTypeCosmos)
+	}
+	if ctx.FeatureEnabled(features.AddressMapperVersion1_5, false) {
+		allowedSigTypes = append(allowedSigTypes, SignatureTypeEd25519)
+	}
+	return allowedSigTypes
+}
+
+func loadMapping(ctx contract.StaticContext, addr loom.Address) (*AddressMapping, error) {
+	var mapping AddressMapping
+	if err := ctx.Get(addressKey(addr), &mapping); err != nil {
+		if err == contract.ErrNotFound {
+			return nil, ErrMappingNotFound
+		}
+		return nil, errors.Wrapf(err, "[Address Mapper] failed to load mapping for address: %v", addr)
+	}
+	return &mapping, nil
+}
+
+func setMapping(ctx contract.Context, from, to loom.Address) error {
+	err := ctx.Set(addressKey(from), &AddressMapping{
+		From: from.MarshalPB(),
+		To:   to.MarshalPB(),
+	})
+	if err != nil {
+		return err
+	}
+	err = ctx.Set(addressKey(to), &AddressMapping{
+		From: to.MarshalPB(),
+		To:   from.MarshalPB(),
+	})
+	if err != nil {
+		return err
+	}
+	if ctx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		return indexMapping(ctx, from, to)
+	}
+	return nil
+}
+
+// mappingCooldownEnd returns the Unix timestamp at which the cooldown that started when the mapping
+// of the given account was last changed ends, or zero if the mapping was never changed.
+func mappingCooldownEnd(ctx contract.StaticContext, addr loom.Address) (int64, error) {
+	var change MappingChange
+	if err := ctx.Get(mappingChangeKey(addr), &change); err != nil {
+		if err == contract.ErrNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "[Address Mapper] failed to load mapping change for address: %v", addr)
+	}
+	return change.ChangedAt + int64(MappingChangeCooldown/time.Second), nil
+}
+
+func checkMappingCooldown(ctx contract.StaticContext, addrs ...loom.Address) error {
+	now := ctx.Now().Unix()
+	for _, addr := range addrs {
+		endsAt, err := mappingCooldownEnd(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if now < endsAt {
+			return ErrMappingCooldown
+		}
+	}
+	return nil
+}
+
+func recordMappingChange(ctx contract.Context, addrs ...loom.Address) error {
+	now := ctx.Now().Unix()
+	for _, addr := range addrs {
+		err := ctx.Set(mappingChangeKey(addr), &MappingChange{
+			Account:   addr.MarshalPB(),
+			ChangedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadRemovalNonce(ctx contract.StaticContext, addr loom.Address) (uint64, error) {
+	var nonce MappingRemovalNonce
+	if err := ctx.Get(removalNonceKey(addr), &nonce); err != nil {
+		if err == contract.ErrNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "[Address Mapper] failed to load removal nonce for address: %v", addr)
+	}
+	return nonce.Nonce, nil
+}
+
+func incrementRemovalNonce(ctx contract.Context, addr loom.Address) error {
+	nonce, err := loadRemovalNonce(ctx, addr)
+	if err != nil {
+		return err
+	}
+	return ctx.Set(removalNonceKey(addr), &MappingRemovalNonce{
+		Account: addr.MarshalPB(),
+		Nonce:   nonce + 1,
+	})
+}
+
+func emitEvent(ctx contract.Context, event proto.Message, topic string) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	ctx.EmitTopics(data, topic)
+	return nil
+}
+
 func verifySig(from, to loom.Address, chainID string, sig []byte, allowedSigTypes []evmcompat.SignatureType) error {
 	if (chainID != from.ChainID) && (chainID != to.ChainID) {
 		return fmt.Errorf("chain ID %s doesn't match either address", chainID)
 	}
 
-	hash := ssha.SoliditySHA3(
-		ssha.Address(common.BytesToAddress(from.Local)),
-		ssha.Address(common.BytesToAddress(to.Local)),
-	)
+	hash := IdentityMappingHash(from, to)
 
 	sigType := evmcompat.SignatureType(sig[0])
 	if sigType == evmcompat.SignatureType_BINANCE {
@@ -219,24 +568,117 @@ func verifySig(from, to loom.Address, chainID string, sig []byte, allowedSigType
 		)
 	}
 
-	signerAddr, err := evmcompat.RecoverAddressFromTypedSig(hash, sig, allowedSigTypes)
+	signerAddr, err := recoverSignerAddress(chainID, hash, sig, allowedSigTypes)
 	if err != nil {
 		return err
 	}
 
-	if (chainID == from.ChainID) && (bytes.Compare(signerAddr.Bytes(), from.Local) != 0) {
-		return fmt.Errorf("signer address doesn't match, %s != %s", signerAddr.Hex(), from.Local.String())
-	} else if (chainID == to.ChainID) && (bytes.Compare(signerAddr.Bytes(), to.Local) != 0) {
-		return fmt.Errorf("signer address doesn't match, %s != %s", signerAddr.Hex(), to.Local.String())
+	if (chainID == from.ChainID) && (bytes.Compare(signerAddr, from.Local) != 0) {
+		return fmt.Errorf("signer address doesn't match, %s != %s", signerAddr.String(), from.Local.String())
+	} else if (chainID == to.ChainID) && (bytes.Compare(signerAddr, to.Local) != 0) {
+		return fmt.Errorf("signer address doesn't match, %s != %s", signerAddr.String(), to.Local.String())
 	}
 	return nil
 }
 
-func SignIdentityMapping(from, to loom.Address, key *ecdsa.PrivateKey, sigType evmcompat.SignatureType) ([]byte, error) {
-	hash := ssha.SoliditySHA3(
+// recoverSignerAddress returns the address of the account that signed the given hash, chainID is
+// the chain ID of the signer's account.
+func recoverSignerAddress(
+	chainID string, hash []byte, sig []byte, allowedSigTypes []evmcompat.SignatureType,
+) (loom.LocalAddress, error) {
+	if len(sig) == 0 {
+		return nil, errors.New("invalid signature length")
+	}
+	switch evmcompat.SignatureType(sig[0]) {
+	case SignatureTypeSecp256r1, SignatureTypeCosmos, SignatureTypeEd25519:
+		return recoverAddressFromSigWithPubKey(chainID, hash, sig, allowedSigTypes)
+	}
+	ethAddr, err := evmcompat.RecoverAddressFromTypedSig(hash, sig, allowedSigTypes)
+	if err != nil {
+		return nil, err
+	}
+	return ethAddr.Bytes(), nil
+}
+
+// recoverAddressFromSigWithPubKey verifies a signature that includes the signer's public key, and
+// returns the address derived from the public key. Cosmos signatures are ADR-036 signatures of the
+// hash, the chain ID of the signer's account is used as the bech32 prefix of the signer address.
+func recoverAddressFromSigWithPubKey(
+	chainID string, hash []byte, sig []byte, allowedSigTypes []evmcompat.SignatureType,
+) (loom.LocalAddress, error) {
+	sigType := evmcompat.SignatureType(sig[0])
+	allowed := false
+	for _, allowedSigType := range allowedSigTypes {
+		if sigType == allowedSigType {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return nil, fmt.Errorf("signature type %v not allowed", sigType)
+	}
+	if len(sig) < 2 || len(sig) < 2+int(sig[1]) {
+		return nil, errors.New("invalid signature length")
+	}
+	pubKey := sig[2 : 2+int(sig[1])]
+	rawSig := sig[2+int(sig[1]):]
+
+	switch sigType {
+	case SignatureTypeSecp256r1:
+		if err := keys.VerifySecp256r1(pubKey, hash, rawSig); err != nil {
+			return nil, err
+		}
+		return keys.LocalAddressFromSecp256r1PubKey(pubKey)
+	case SignatureTypeCosmos:
+		if err := keys.VerifyCosmos(pubKey, chainID, hash, rawSig); err != nil {
+			return nil, err
+		}
+		return keys.LocalAddressFromCosmosPubKey(pubKey)
+	case SignatureTypeEd25519:
+		if len(pubKey) != ed25519.PublicKeySize || !ed25519.Verify(pubKey, hash, rawSig) {
+			return nil, errors.New("invalid ed25519 signature")
+		}
+		return loom.LocalAddressFromPublicKey(pubKey), nil
+	}
+	return nil, fmt.Errorf("unsupported signature type %v", sigType)
+}
+
+// IdentityMappingHash returns the hash that must be signed to prove ownership of one of the
+// accounts in a mapping.
+func IdentityMappingHash(from, to loom.Address) []byte {
+	return ssha.SoliditySHA3(
 		ssha.Address(common.BytesToAddress(from.Local)),
 		ssha.Address(common.BytesToAddress(to.Local)),
 	)
+}
+
+// MappingRemovalHash returns the hash that must be signed by an account to remove its mapping to
+// another account, nonce must be the current removal nonce of the from account.
+func MappingRemovalHash(from, to loom.Address, nonce uint64) []byte {
+	return ssha.SoliditySHA3(
+		ssha.String(mappingRemovalHashTypeName),
+		ssha.String(from.ChainID),
+		ssha.Address(common.BytesToAddress(from.Local)),
+		ssha.String(to.ChainID),
+		ssha.Address(common.BytesToAddress(to.Local)),
+		ssha.Uint64(nonce),
+	)
+}
+
+// EncodeSigWithPubKey encodes a signature produced by a secp256r1, Cosmos, or ed25519 key, along
+// with the signer's public key, so it can be passed to AddIdentityMapping or AddSessionKey.
+func EncodeSigWithPubKey(sigType evmcompat.SignatureType, pubKey []byte, sig []byte) ([]byte, error) {
+	if len(pubKey) > 255 {
+		return nil, errors.New("public key too long")
+	}
+	encoded := make([]byte, 0, 2+len(pubKey)+len(sig))
+	encoded = append(encoded, byte(sigType), byte(len(pubKey)))
+	encoded = append(encoded, pubKey...)
+	return append(encoded, sig...), nil
+}
+
+func SignIdentityMapping(from, to loom.Address, key *ecdsa.PrivateKey, sigType evmcompat.SignatureType) ([]byte, error) {
+	hash := IdentityMappingHash(from, to)
 
 	if sigType == evmcompat.SignatureType_TRON {
 		hash = evmcompat.PrefixHeader(hash, evmcompat.SignatureType_TRON)
@@ -250,4 +692,16 @@ func SignIdentityMapping(from, to loom.Address, key *ecdsa.PrivateKey, sigType e
 	return evmcompat.GenerateTypedSig(hash, key, sigType)
 }
 
+// SignMappingRemoval signs the removal of the mapping between the given accounts, the key must be
+// the key of the from account, and nonce its current removal nonce.
+func SignMappingRemoval(
+	from, to loom.Address, nonce uint64, key *ecdsa.PrivateKey, sigType evmcompat.SignatureType,
+) ([]byte, error) {
+	hash := MappingRemovalHash(from, to, nonce)
+	if sigType == evmcompat.SignatureType_TRON {
+		hash = evmcompat.PrefixHeader(hash, evmcompat.SignatureType_TRON)
+	}
+	return evmcompat.GenerateTypedSig(hash, key, sigType)
+}
+
 var Contract plugin.Contract = contract.MakePluginContract(&AddressMapper{})
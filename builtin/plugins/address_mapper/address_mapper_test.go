@@ -4,9 +4,13 @@ package address_mapper
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -15,9 +19,14 @@ import (
 	"github.com/loomnetwork/go-loom/common/evmcompat"
 	"github.com/loomnetwork/go-loom/plugin"
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/auth/keys"
 	"github.com/loomnetwork/loomchain/features"
 	ssha "github.com/miguelmota/go-solidity-sha3"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	tmsecp256k1 "github.com/tendermint/tendermint/crypto/secp256k1"
+	"golang.org/x/crypto/ed25519"
 )
 
 var (
@@ -419,3 +428,546 @@ func (s *AddressMapperTestSuite) TestBinanceSigRecovery() {
 	r.NoError(err)
 	r.Equal(common.HexToAddress("0x131cD1A71cBc107b773c1763e7c9E11b26548F0c").Hex(), addr.Hex())
 }
+
+func (s *AddressMapperTestSuite) TestSecp256r1IdentityMapping() {
+	r := s.Require()
+	fakeCtx := plugin.CreateFakeContext(s.validDAppAddr /*caller*/, loom.RootAddress("chain") /*contract*/)
+	ctx := contract.WrapPluginContext(fakeCtx)
+
+	amContract := &AddressMapper{}
+	r.NoError(amContract.Init(ctx, &InitRequest{}))
+
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	r.NoError(err)
+	pubKey := elliptic.Marshal(elliptic.P256(), privKey.X, privKey.Y)
+	localAddr, err := keys.LocalAddressFromSecp256r1PubKey(pubKey)
+	r.NoError(err)
+	foreignAddr := loom.Address{ChainID: "passkey", Local: localAddr}
+
+	sig, err := EncodeSigWithPubKey(
+		SignatureTypeSecp256r1, pubKey,
+		signSecp256r1(s.T(), privKey, IdentityMappingHash(s.validDAppAddr, foreignAddr)),
+	)
+	r.NoError(err)
+	req := &AddIdentityMappingRequest{
+		From:      s.validDAppAddr.MarshalPB(),
+		To:        foreignAddr.MarshalPB(),
+		Signature: sig,
+	}
+
+	// secp256r1 signatures are only accepted once addrmapper:v1.4 is enabled
+	r.Error(amContract.AddIdentityMapping(ctx, req))
+	fakeCtx.SetFeature(features.AddressMapperVersion1_4, true)
+
+	// the signature must be produced by the foreign account
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	r.NoError(err)
+	badSig, err := EncodeSigWithPubKey(
+		SignatureTypeSecp256r1, pubKey,
+		signSecp256r1(s.T(), otherKey, IdentityMappingHash(s.validDAppAddr, foreignAddr)),
+	)
+	r.NoError(err)
+	r.Error(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validDAppAddr.MarshalPB(),
+		To:        foreignAddr.MarshalPB(),
+		Signature: badSig,
+	}))
+
+	r.NoError(amContract.AddIdentityMapping(ctx, req))
+	resp, err := amContract.GetMapping(ctx, &GetMappingRequest{From: foreignAddr.MarshalPB()})
+	r.NoError(err)
+	s.Equal(0, loom.UnmarshalAddressPB(resp.To).Compare(s.validDAppAddr))
+}
+
+func (s *AddressMapperTestSuite) TestCosmosIdentityMapping() {
+	r := s.Require()
+	fakeCtx := plugin.CreateFakeContext(s.validDAppAddr /*caller*/, loom.RootAddress("chain") /*contract*/)
+	ctx := contract.WrapPluginContext(fakeCtx)
+
+	amContract := &AddressMapper{}
+	r.NoError(amContract.Init(ctx, &InitRequest{}))
+	fakeCtx.SetFeature(features.AddressMapperVersion1_4, true)
+
+	privKey := tmsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().Bytes()
+	foreignAddr := loom.Address{ChainID: "cosmos", Local: loom.LocalAddress(privKey.PubKey().Address())}
+	hash := IdentityMappingHash(s.validDAppAddr, foreignAddr)
+
+	// a raw signature of the hash isn't accepted, it must be signed as an ADR-036 sign doc
+	rawSig, err := privKey.Sign(hash)
+	r.NoError(err)
+	sig, err := EncodeSigWithPubKey(SignatureTypeCosmos, pubKey, rawSig)
+	r.NoError(err)
+	r.Error(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validDAppAddr.MarshalPB(),
+		To:        foreignAddr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	// the sign doc signer must be bech32 encoded with the foreign chain ID as the prefix
+	signBytes, err := keys.CosmosSignBytes("osmo", pubKey, hash)
+	r.NoError(err)
+	wrongPrefixSig, err := privKey.Sign(signBytes)
+	r.NoError(err)
+	sig, err = EncodeSigWithPubKey(SignatureTypeCosmos, pubKey, wrongPrefixSig)
+	r.NoError(err)
+	r.Error(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validDAppAddr.MarshalPB(),
+		To:        foreignAddr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	signBytes, err = keys.CosmosSignBytes("cosmos", pubKey, hash)
+	r.NoError(err)
+	adr036Sig, err := privKey.Sign(signBytes)
+	r.NoError(err)
+	sig, err = EncodeSigWithPubKey(SignatureTypeCosmos, pubKey, adr036Sig)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validDAppAddr.MarshalPB(),
+		To:        foreignAddr.MarshalPB(),
+		Signature: sig,
+	}))
+	resp, err := amContract.GetMapping(ctx, &GetMappingRequest{From: foreignAddr.MarshalPB()})
+	r.NoError(err)
+	s.Equal(0, loom.UnmarshalAddressPB(resp.To).Compare(s.validDAppAddr))
+}
+
+func (s *AddressMapperTestSuite) TestRemoveMapping() {
+	r := s.Require()
+	now := time.Now()
+	fakeCtx := plugin.CreateFakeContext(s.validDAppAddr /*caller*/, loom.RootAddress("chain") /*contract*/).
+		WithBlock(loom.BlockHeader{ChainID: "chain", Time: now.Unix()})
+	ctx := contract.WrapPluginContext(fakeCtx)
+
+	amContract := &AddressMapper{}
+	r.NoError(amContract.Init(ctx, &InitRequest{}))
+
+	sig, err := SignIdentityMapping(s.validEthAddr, s.validDAppAddr, s.validEthKey, sigType)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	removalSig, err := SignMappingRemoval(s.validEthAddr, s.validDAppAddr, 0, s.validEthKey, sigType)
+	r.NoError(err)
+	removeReq := &RemoveMappingRequest{From: s.validEthAddr.MarshalPB(), Signature: removalSig}
+	ethCtx := contract.WrapPluginContext(fakeCtx.WithSender(s.validEthAddr))
+
+	// RemoveMapping can't be used until addrmapper:v1.2 is enabled
+	r.Equal(errMappingChangesDisabled, amContract.RemoveMapping(ethCtx, removeReq))
+	_, err = amContract.GetMapping(ctx, &GetMappingRequest{From: s.validDAppAddr.MarshalPB()})
+	r.NoError(err)
+
+	fakeCtx.SetFeature(features.AddressMapperVersion1_2, true)
+	r.Equal(ErrNotAuthorized, amContract.RemoveMapping(ctx, removeReq))
+	r.Equal(ErrMappingNotFound, amContract.RemoveMapping(
+		contract.WrapPluginContext(fakeCtx.WithSender(s.validDAppAddr2)),
+		&RemoveMappingRequest{From: s.validDAppAddr2.MarshalPB(), Signature: removalSig},
+	))
+	// the removal must be signed by the caller's account
+	r.Equal(ErrInvalidRequest, amContract.RemoveMapping(
+		ethCtx, &RemoveMappingRequest{From: s.validEthAddr.MarshalPB()},
+	))
+	badSig, err := SignMappingRemoval(s.validEthAddr, s.validDAppAddr, 0, s.invalidEthKey, sigType)
+	r.NoError(err)
+	r.Error(amContract.RemoveMapping(
+		ethCtx, &RemoveMappingRequest{From: s.validEthAddr.MarshalPB(), Signature: badSig},
+	))
+	// a signature that adds the mapping can't be used to remove it
+	r.Error(amContract.RemoveMapping(
+		ethCtx, &RemoveMappingRequest{From: s.validEthAddr.MarshalPB(), Signature: sig},
+	))
+	r.NoError(amContract.RemoveMapping(ethCtx, removeReq))
+
+	for _, addr := range []loom.Address{s.validDAppAddr, s.validEthAddr} {
+		hasMappingResponse, err := amContract.HasMapping(ctx, &HasMappingRequest{From: addr.MarshalPB()})
+		r.NoError(err)
+		s.Equal(false, hasMappingResponse.HasMapping)
+	}
+
+	// The accounts can't be mapped again until the cooldown ends
+	cooldownResp, err := amContract.GetMappingCooldown(ctx, &GetMappingCooldownRequest{
+		Account: s.validDAppAddr.MarshalPB(),
+	})
+	r.NoError(err)
+	s.Equal(now.Add(MappingChangeCooldown).Unix(), cooldownResp.EndsAt)
+
+	r.Equal(ErrMappingCooldown, amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	ctx = contract.WrapPluginContext(fakeCtx.WithBlock(loom.BlockHeader{
+		ChainID: "chain",
+		Time:    now.Add(MappingChangeCooldown).Unix(),
+	}))
+	cooldownResp, err = amContract.GetMappingCooldown(ctx, &GetMappingCooldownRequest{
+		Account: s.validDAppAddr.MarshalPB(),
+	})
+	r.NoError(err)
+	s.Equal(int64(0), cooldownResp.EndsAt)
+	r.NoError(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	// the removal nonce is incremented by each removal, so the removal signature can't be replayed
+	// to remove the new mapping
+	nonceResp, err := amContract.GetMappingRemovalNonce(ctx, &GetMappingRemovalNonceRequest{
+		Account: s.validEthAddr.MarshalPB(),
+	})
+	r.NoError(err)
+	s.Equal(uint64(1), nonceResp.Nonce)
+	ethCtx = contract.WrapPluginContext(fakeCtx.WithSender(s.validEthAddr).WithBlock(loom.BlockHeader{
+		ChainID: "chain",
+		Time:    now.Add(2 * MappingChangeCooldown).Unix(),
+	}))
+	r.Error(amContract.RemoveMapping(ethCtx, removeReq))
+	// a signature for a different chain ID can't be used either
+	otherChainSig, err := SignMappingRemoval(
+		loom.Address{ChainID: "other", Local: s.validEthAddr.Local}, s.validDAppAddr, 1, s.validEthKey, sigType,
+	)
+	r.NoError(err)
+	r.Error(amContract.RemoveMapping(
+		ethCtx, &RemoveMappingRequest{From: s.validEthAddr.MarshalPB(), Signature: otherChainSig},
+	))
+	removalSig, err = SignMappingRemoval(s.validEthAddr, s.validDAppAddr, 1, s.validEthKey, sigType)
+	r.NoError(err)
+	r.NoError(amContract.RemoveMapping(
+		ethCtx, &RemoveMappingRequest{From: s.validEthAddr.MarshalPB(), Signature: removalSig},
+	))
+
+	// local accounts sign the removal with their ed25519 key
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	r.NoError(err)
+	localAddr := loom.Address{ChainID: "chain", Local: loom.LocalAddressFromPublicKey(pubKey)}
+	localCtx := contract.WrapPluginContext(fakeCtx.WithSender(localAddr))
+	ethKey, err := crypto.GenerateKey()
+	r.NoError(err)
+	ethAddr := loom.Address{ChainID: "eth", Local: crypto.PubkeyToAddress(ethKey.PublicKey).Bytes()}
+	sig, err = SignIdentityMapping(ethAddr, localAddr, ethKey, sigType)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(localCtx, &AddIdentityMappingRequest{
+		From:      ethAddr.MarshalPB(),
+		To:        localAddr.MarshalPB(),
+		Signature: sig,
+	}))
+	removalSig, err = EncodeSigWithPubKey(
+		SignatureTypeEd25519, pubKey, ed25519.Sign(privKey, MappingRemovalHash(localAddr, ethAddr, 0)),
+	)
+	r.NoError(err)
+	r.NoError(amContract.RemoveMapping(
+		localCtx, &RemoveMappingRequest{From: localAddr.MarshalPB(), Signature: removalSig},
+	))
+}
+
+func (s *AddressMapperTestSuite) TestRebindMapping() {
+	r := s.Require()
+	now := time.Now()
+	fakeCtx := plugin.CreateFakeContext(s.validDAppAddr /*caller*/, loom.RootAddress("chain") /*contract*/).
+		WithBlock(loom.BlockHeader{ChainID: "chain", Time: now.Unix()})
+	ctx := contract.WrapPluginContext(fakeCtx)
+
+	amContract := &AddressMapper{}
+	r.NoError(amContract.Init(ctx, &InitRequest{}))
+
+	sig, err := SignIdentityMapping(s.validEthAddr, s.validDAppAddr, s.validEthKey, sigType)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	sig, err = SignIdentityMapping(s.validTronAddr, s.validDAppAddr2, s.validTronKey, evmcompat.SignatureType_TRON)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(
+		contract.WrapPluginContext(fakeCtx.WithSender(s.validDAppAddr2)),
+		&AddIdentityMappingRequest{
+			From:      s.validTronAddr.MarshalPB(),
+			To:        s.validDAppAddr2.MarshalPB(),
+			Signature: sig,
+		},
+	))
+
+	newEthKey, err := crypto.GenerateKey()
+	r.NoError(err)
+	newEthAddr := loom.Address{ChainID: "eth", Local: crypto.PubkeyToAddress(newEthKey.PublicKey).Bytes()}
+	newSig, err := SignIdentityMapping(newEthAddr, s.validDAppAddr, newEthKey, sigType)
+	r.NoError(err)
+	rebindReq := &RebindMappingRequest{
+		From:      newEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: newSig,
+	}
+
+	r.Equal(errMappingChangesDisabled, amContract.RebindMapping(ctx, rebindReq))
+	fakeCtx.SetFeature(features.AddressMapperVersion1_2, true)
+
+	// the signature must be produced by the new account
+	badSig, err := SignIdentityMapping(newEthAddr, s.validDAppAddr, s.invalidEthKey, sigType)
+	r.NoError(err)
+	r.Error(amContract.RebindMapping(ctx, &RebindMappingRequest{
+		From:      newEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: badSig,
+	}))
+	// the caller must be one of the accounts
+	r.Equal(ErrInvalidRequest, amContract.RebindMapping(
+		contract.WrapPluginContext(fakeCtx.WithSender(s.validDAppAddr3)), rebindReq,
+	))
+
+	r.NoError(amContract.RebindMapping(ctx, rebindReq))
+
+	resp, err := amContract.GetMapping(ctx, &GetMappingRequest{From: s.validDAppAddr.MarshalPB()})
+	r.NoError(err)
+	s.Equal(newEthAddr.MarshalPB(), resp.To)
+	resp, err = amContract.GetMapping(ctx, &GetMappingRequest{From: newEthAddr.MarshalPB()})
+	r.NoError(err)
+	s.Equal(s.validDAppAddr.MarshalPB(), resp.To)
+	hasMappingResponse, err := amContract.HasMapping(ctx, &HasMappingRequest{From: s.validEthAddr.MarshalPB()})
+	r.NoError(err)
+	s.Equal(false, hasMappingResponse.HasMapping)
+
+	// The mapping can't be changed again until the cooldown ends
+	sig, err = SignIdentityMapping(s.validEthAddr, s.validDAppAddr, s.validEthKey, sigType)
+	r.NoError(err)
+	rebindReq = &RebindMappingRequest{
+		From:      s.validEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: sig,
+	}
+	r.Equal(ErrMappingCooldown, amContract.RebindMapping(ctx, rebindReq))
+	removalSig, err := SignMappingRemoval(newEthAddr, s.validDAppAddr, 0, newEthKey, sigType)
+	r.NoError(err)
+	r.Equal(ErrMappingCooldown, amContract.RemoveMapping(
+		contract.WrapPluginContext(fakeCtx.WithSender(newEthAddr)),
+		&RemoveMappingRequest{From: newEthAddr.MarshalPB(), Signature: removalSig},
+	))
+
+	ctx = contract.WrapPluginContext(fakeCtx.WithBlock(loom.BlockHeader{
+		ChainID: "chain",
+		Time:    now.Add(MappingChangeCooldown).Unix(),
+	}))
+	// can't rebind to an account that's mapped to another account
+	sig, err = SignIdentityMapping(s.validTronAddr, s.validDAppAddr, s.validTronKey, evmcompat.SignatureType_TRON)
+	r.NoError(err)
+	r.Equal(ErrAlreadyRegistered, amContract.RebindMapping(ctx, &RebindMappingRequest{
+		From:      s.validTronAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: sig,
+	}))
+	r.NoError(amContract.RebindMapping(ctx, rebindReq))
+
+	resp, err = amContract.GetMapping(ctx, &GetMappingRequest{From: s.validDAppAddr.MarshalPB()})
+	r.NoError(err)
+	s.Equal(s.validEthAddr.MarshalPB(), resp.To)
+}
+
+func (s *AddressMapperTestSuite) TestMappingIndex() {
+	r := s.Require()
+	fakeCtx := plugin.CreateFakeContext(s.validDAppAddr /*caller*/, loom.RootAddress("chain") /*contract*/).
+		WithBlock(loom.BlockHeader{ChainID: "chain", Time: time.Now().Unix()})
+	ctx := contract.WrapPluginContext(fakeCtx)
+
+	amContract := &AddressMapper{}
+	r.NoError(amContract.Init(ctx, &InitRequest{}))
+
+	addEthMapping := func() (loom.Address, loom.Address) {
+		ethKey, err := crypto.GenerateKey()
+		r.NoError(err)
+		ethAddr := loom.Address{ChainID: "eth", Local: crypto.PubkeyToAddress(ethKey.PublicKey).Bytes()}
+		dappKey, err := crypto.GenerateKey()
+		r.NoError(err)
+		dappAddr := loom.Address{ChainID: "chain", Local: crypto.PubkeyToAddress(dappKey.PublicKey).Bytes()}
+		sig, err := SignIdentityMapping(ethAddr, dappAddr, ethKey, sigType)
+		r.NoError(err)
+		r.NoError(amContract.AddIdentityMapping(
+			contract.WrapPluginContext(fakeCtx.WithSender(dappAddr)),
+			&AddIdentityMappingRequest{
+				From:      ethAddr.MarshalPB(),
+				To:        dappAddr.MarshalPB(),
+				Signature: sig,
+			},
+		))
+		return ethAddr, dappAddr
+	}
+
+	// mapping added before the index is enabled
+	addEthMapping()
+
+	_, err := amContract.GetMappingCount(ctx, &GetMappingCountRequest{})
+	r.Equal(errMappingIndexDisabled, err)
+	fakeCtx.SetFeature(features.AddressMapperVersion1_3, true)
+
+	resp, err := amContract.GetMappingCount(ctx, &GetMappingCountRequest{})
+	r.NoError(err)
+	s.Equal(uint64(0), resp.Count)
+
+	r.NoError(BuildMappingIndex(ctx))
+	// building the index again shouldn't change anything
+	r.NoError(BuildMappingIndex(ctx))
+	resp, err = amContract.GetMappingCount(ctx, &GetMappingCountRequest{})
+	r.NoError(err)
+	s.Equal(uint64(1), resp.Count)
+
+	for i := 0; i < 4; i++ {
+		addEthMapping()
+	}
+	sig, err := SignIdentityMapping(s.validTronAddr, s.validDAppAddr2, s.validTronKey, evmcompat.SignatureType_TRON)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(
+		contract.WrapPluginContext(fakeCtx.WithSender(s.validDAppAddr2)),
+		&AddIdentityMappingRequest{
+			From:      s.validTronAddr.MarshalPB(),
+			To:        s.validDAppAddr2.MarshalPB(),
+			Signature: sig,
+		},
+	))
+
+	resp, err = amContract.GetMappingCount(ctx, &GetMappingCountRequest{})
+	r.NoError(err)
+	s.Equal(uint64(6), resp.Count)
+	resp, err = amContract.GetMappingCount(ctx, &GetMappingCountRequest{ChainId: "eth"})
+	r.NoError(err)
+	s.Equal(uint64(5), resp.Count)
+	resp, err = amContract.GetMappingCount(ctx, &GetMappingCountRequest{ChainId: "tron"})
+	r.NoError(err)
+	s.Equal(uint64(1), resp.Count)
+
+	// page through all the mappings
+	var mappings []*IndexedMapping
+	var cursor []byte
+	for {
+		page, err := amContract.ListMappingPage(ctx, &ListMappingPageRequest{Cursor: cursor, Limit: 2})
+		r.NoError(err)
+		r.True(len(page.Mappings) <= 2)
+		mappings = append(mappings, page.Mappings...)
+		if len(page.NextCursor) == 0 {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	r.Len(mappings, 6)
+	seen := map[string]bool{}
+	for i, mapping := range mappings {
+		foreignAddr := loom.UnmarshalAddressPB(mapping.Foreign)
+		s.NotEqual("chain", foreignAddr.ChainID)
+		s.Equal("chain", loom.UnmarshalAddressPB(mapping.Local).ChainID)
+		s.False(seen[foreignAddr.String()])
+		seen[foreignAddr.String()] = true
+		if i < 5 {
+			s.Equal("eth", foreignAddr.ChainID)
+		} else {
+			s.Equal("tron", foreignAddr.ChainID)
+			s.Equal(s.validDAppAddr2.MarshalPB(), mapping.Local)
+		}
+	}
+
+	page, err := amContract.ListMappingPage(ctx, &ListMappingPageRequest{ChainId: "tron"})
+	r.NoError(err)
+	r.Len(page.Mappings, 1)
+	s.Equal(s.validTronAddr.MarshalPB(), page.Mappings[0].Foreign)
+	s.Empty(page.NextCursor)
+
+	// removed mappings should be removed from the index
+	fakeCtx.SetFeature(features.AddressMapperVersion1_2, true)
+	removalSig, err := SignMappingRemoval(
+		s.validTronAddr, s.validDAppAddr2, 0, s.validTronKey, evmcompat.SignatureType_TRON,
+	)
+	r.NoError(err)
+	r.NoError(amContract.RemoveMapping(
+		contract.WrapPluginContext(fakeCtx.WithSender(s.validTronAddr)),
+		&RemoveMappingRequest{From: s.validTronAddr.MarshalPB(), Signature: removalSig},
+	))
+	resp, err = amContract.GetMappingCount(ctx, &GetMappingCountRequest{ChainId: "tron"})
+	r.NoError(err)
+	s.Equal(uint64(0), resp.Count)
+	page, err = amContract.ListMappingPage(ctx, &ListMappingPageRequest{})
+	r.NoError(err)
+	r.Len(page.Mappings, 5)
+}
+
+func (s *AddressMapperTestSuite) TestSessionKeys() {
+	r := s.Require()
+	now := time.Now()
+	fakeCtx := plugin.CreateFakeContext(s.validDAppAddr /*caller*/, loom.RootAddress("chain") /*contract*/).
+		WithBlock(loom.BlockHeader{ChainID: "chain", Time: now.Unix()})
+	ctx := contract.WrapPluginContext(fakeCtx)
+
+	amContract := &AddressMapper{}
+	r.NoError(amContract.Init(ctx, &InitRequest{}))
+
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	r.NoError(err)
+	sessionKeyAddr := loom.Address{ChainID: "chain", Local: loom.LocalAddressFromPublicKey(pubKey)}
+	sig, err := EncodeSigWithPubKey(
+		SignatureTypeEd25519, pubKey,
+		ed25519.Sign(privKey, SessionKeyAuthHash(s.validDAppAddr, sessionKeyAddr)),
+	)
+	r.NoError(err)
+	req := &AddSessionKeyRequest{
+		SessionKey:       sessionKeyAddr.MarshalPB(),
+		ExpiresAt:        uint64(now.Add(time.Hour).Unix()),
+		AllowedContracts: []*types.Address{addr2.MarshalPB()},
+		AllowedMethods:   []string{"Transfer"},
+		TxBudget:         10,
+		Signature:        sig,
+	}
+
+	r.Equal(errSessionKeysDisabled, amContract.AddSessionKey(ctx, req))
+	fakeCtx.SetFeature(features.AddressMapperVersion1_5, true)
+
+	// The session key must sign the authorization for the owner that's adding it
+	err = amContract.AddSessionKey(contract.WrapPluginContext(fakeCtx.WithSender(s.validDAppAddr2)), req)
+	r.Error(err)
+
+	// The session key can't be allowed to call the Address Mapper
+	req.AllowedContracts = append(req.AllowedContracts, loom.RootAddress("chain").MarshalPB())
+	r.Error(amContract.AddSessionKey(ctx, req))
+	req.AllowedContracts = req.AllowedContracts[:1]
+
+	r.NoError(amContract.AddSessionKey(ctx, req))
+	getResp, err := amContract.GetSessionKey(ctx, &GetSessionKeyRequest{SessionKey: sessionKeyAddr.MarshalPB()})
+	r.NoError(err)
+	s.Equal(0, loom.UnmarshalAddressPB(getResp.SessionKey.Owner).Compare(s.validDAppAddr))
+	s.Equal(uint64(now.Unix()), getResp.SessionKey.CreatedAt)
+	s.Equal(uint64(10), getResp.SessionKey.TxBudget)
+
+	listResp, err := amContract.ListSessionKeys(ctx, &ListSessionKeysRequest{Owner: s.validDAppAddr.MarshalPB()})
+	r.NoError(err)
+	r.Len(listResp.SessionKeys, 1)
+
+	// Only the owner can revoke the session key
+	r.Equal(ErrNotAuthorized, amContract.RemoveSessionKey(
+		contract.WrapPluginContext(fakeCtx.WithSender(s.validDAppAddr2)),
+		&RemoveSessionKeyRequest{SessionKey: sessionKeyAddr.MarshalPB()},
+	))
+	r.NoError(amContract.RemoveSessionKey(ctx, &RemoveSessionKeyRequest{SessionKey: sessionKeyAddr.MarshalPB()}))
+	_, err = amContract.GetSessionKey(ctx, &GetSessionKeyRequest{SessionKey: sessionKeyAddr.MarshalPB()})
+	r.Equal(ErrSessionKeyNotFound, err)
+	listResp, err = amContract.ListSessionKeys(ctx, &ListSessionKeysRequest{Owner: s.validDAppAddr.MarshalPB()})
+	r.NoError(err)
+	r.Len(listResp.SessionKeys, 0)
+
+	// Session keys that have already expired can't be added
+	req.ExpiresAt = uint64(now.Unix())
+	r.Error(amContract.AddSessionKey(ctx, req))
+}
+
+// signSecp256r1 returns a raw 64-byte (R || S) secp256r1 signature of the SHA-256 hash of msg.
+func signSecp256r1(t *testing.T, privKey *ecdsa.PrivateKey, msg []byte) []byte {
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, privKey, hash[:])
+	require.NoError(t, err)
+	sig := make([]byte, keys.Secp256r1SignatureSize)
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rBytes):32], rBytes)
+	copy(sig[64-len(sBytes):], sBytes)
+	return sig
+}
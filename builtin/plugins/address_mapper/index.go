@@ -0,0 +1,279 @@
+// +build evm
+
+package address_mapper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	amtypes "github.com/loomnetwork/go-loom/builtin/types/address_mapper"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/pkg/errors"
+)
+
+// MAPPING INDEX
+//
+// Every mapping is stored twice under AddressPrefix (once for each direction), so listing the
+// mappings requires loading all of them and de-duplicating them in memory. When addrmapper:v1.3 is
+// enabled each mapping is also stored once in an index keyed by the chain ID & address of the
+// foreign account, with the index for each chain split into 256 buckets (by the first byte of the
+// foreign address) so that a page of mappings can be loaded without loading the whole index.
+// The number of mappings to accounts on each foreign chain is tracked alongside the index.
+//
+// Mappings added before addrmapper:v1.3 was enabled are indexed by BuildMappingIndex, which is run
+// via a migration.
+
+const (
+	defaultMappingPageSize = 100
+	maxMappingPageSize     = 1000
+	numMappingIndexBuckets = 256
+)
+
+var (
+	MappingIndexPrefix = "mapping-idx"
+	MappingCountPrefix = "mapping-count"
+)
+
+func mappingIndexBucketKey(chainID string, bucket byte) []byte {
+	return util.PrefixKey([]byte(MappingIndexPrefix), []byte(chainID), []byte{bucket})
+}
+
+func mappingIndexKey(foreignAddr loom.Address) []byte {
+	return util.PrefixKey(mappingIndexBucketKey(foreignAddr.ChainID, mappingIndexBucket(foreignAddr)), foreignAddr.Local)
+}
+
+func mappingIndexBucket(addr loom.Address) byte {
+	if len(addr.Local) == 0 {
+		return 0
+	}
+	return addr.Local[0]
+}
+
+func mappingCountKey(chainID string) []byte {
+	return util.PrefixKey([]byte(MappingCountPrefix), []byte(chainID))
+}
+
+// splitMapping figures out which of the mapped accounts is the foreign account, i.e. the account
+// that's not on the local chain. If both or neither of the accounts are on the local chain the
+// account with the lower address is considered to be the foreign account.
+func splitMapping(localChainID string, a, b loom.Address) (foreign loom.Address, local loom.Address) {
+	aForeign := a.ChainID != localChainID
+	bForeign := b.ChainID != localChainID
+	if aForeign != bForeign {
+		if aForeign {
+			return a, b
+		}
+		return b, a
+	}
+	if a.Compare(b) < 0 {
+		return a, b
+	}
+	return b, a
+}
+
+// indexMapping adds a mapping to the mapping index, it's a no-op if the mapping is already indexed.
+func indexMapping(ctx contract.Context, a, b loom.Address) error {
+	foreignAddr, localAddr := splitMapping(ctx.Block().ChainID, a, b)
+	key := mappingIndexKey(foreignAddr)
+	if ctx.Has(key) {
+		return nil
+	}
+	err := ctx.Set(key, &IndexedMapping{
+		Foreign: foreignAddr.MarshalPB(),
+		Local:   localAddr.MarshalPB(),
+	})
+	if err != nil {
+		return err
+	}
+	return adjustMappingCount(ctx, foreignAddr.ChainID, 1)
+}
+
+// unindexMapping removes a mapping from the mapping index, it's a no-op if the mapping isn't indexed.
+func unindexMapping(ctx contract.Context, a, b loom.Address) error {
+	foreignAddr, _ := splitMapping(ctx.Block().ChainID, a, b)
+	key := mappingIndexKey(foreignAddr)
+	if !ctx.Has(key) {
+		return nil
+	}
+	ctx.Delete(key)
+	return adjustMappingCount(ctx, foreignAddr.ChainID, -1)
+}
+
+func loadMappingCount(ctx contract.StaticContext, chainID string) (uint64, error) {
+	var count MappingCount
+	if err := ctx.Get(mappingCountKey(chainID), &count); err != nil {
+		if err == contract.ErrNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "[Address Mapper] failed to load mapping count for chain %s", chainID)
+	}
+	return count.Count, nil
+}
+
+func adjustMappingCount(ctx contract.Context, chainID string, delta int64) error {
+	count, err := loadMappingCount(ctx, chainID)
+	if err != nil {
+		return err
+	}
+	if delta < 0 && count < uint64(-delta) {
+		return errors.Errorf("[Address Mapper] mapping count for chain %s can't be negative", chainID)
+	}
+	count = uint64(int64(count) + delta)
+	if count == 0 {
+		ctx.Delete(mappingCountKey(chainID))
+		return nil
+	}
+	return ctx.Set(mappingCountKey(chainID), &MappingCount{
+		ChainId: chainID,
+		Count:   count,
+	})
+}
+
+// loadMappingCounts returns the mapping counts of all the foreign chains, sorted by chain ID.
+func loadMappingCounts(ctx contract.StaticContext) ([]*MappingCount, error) {
+	var counts []*MappingCount
+	for _, item := range ctx.Range([]byte(MappingCountPrefix)) {
+		var count MappingCount
+		if err := proto.Unmarshal(item.Value, &count); err != nil {
+			return nil, errors.Wrap(err, "unmarshal mapping count")
+		}
+		counts = append(counts, &count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].ChainId < counts[j].ChainId
+	})
+	return counts, nil
+}
+
+// loadMappingIndexBucket returns the mappings in a single bucket of the mapping index, sorted by
+// foreign address.
+func loadMappingIndexBucket(ctx contract.StaticContext, chainID string, bucket byte) ([]*IndexedMapping, error) {
+	items := ctx.Range(mappingIndexBucketKey(chainID, bucket))
+	mappings := make([]*IndexedMapping, 0, len(items))
+	for _, item := range items {
+		var mapping IndexedMapping
+		if err := proto.Unmarshal(item.Value, &mapping); err != nil {
+			return nil, errors.Wrap(err, "unmarshal indexed mapping")
+		}
+		mappings = append(mappings, &mapping)
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		return bytes.Compare(mappings[i].Foreign.Local, mappings[j].Foreign.Local) < 0
+	})
+	return mappings, nil
+}
+
+// ListMappingPage returns a page of mappings from the mapping index, ordered by foreign chain ID
+// & foreign address.
+func ListMappingPage(ctx contract.StaticContext, req *ListMappingPageRequest) (*ListMappingPageResponse, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultMappingPageSize
+	} else if limit > maxMappingPageSize {
+		limit = maxMappingPageSize
+	}
+
+	var cursor MappingCursor
+	if len(req.Cursor) > 0 {
+		if err := proto.Unmarshal(req.Cursor, &cursor); err != nil {
+			return nil, errors.Wrap(err, "[Address Mapper] invalid cursor")
+		}
+		if cursor.ChainId == "" || len(cursor.LastForeignLocal) == 0 ||
+			(req.ChainId != "" && req.ChainId != cursor.ChainId) {
+			return nil, errors.Wrap(ErrInvalidRequest, "invalid cursor")
+		}
+	}
+
+	var chainIDs []string
+	if req.ChainId != "" {
+		chainIDs = []string{req.ChainId}
+	} else {
+		counts, err := loadMappingCounts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, count := range counts {
+			chainIDs = append(chainIDs, count.ChainId)
+		}
+	}
+
+	resp := &ListMappingPageResponse{
+		Mappings: []*IndexedMapping{},
+	}
+	for _, chainID := range chainIDs {
+		if chainID < cursor.ChainId {
+			continue
+		}
+		startBucket := 0
+		var lastForeignLocal []byte
+		if chainID == cursor.ChainId {
+			startBucket = int(cursor.LastForeignLocal[0])
+			lastForeignLocal = cursor.LastForeignLocal
+		}
+		for bucket := startBucket; bucket < numMappingIndexBuckets; bucket++ {
+			mappings, err := loadMappingIndexBucket(ctx, chainID, byte(bucket))
+			if err != nil {
+				return nil, err
+			}
+			for _, mapping := range mappings {
+				if lastForeignLocal != nil && bytes.Compare(mapping.Foreign.Local, lastForeignLocal) <= 0 {
+					continue
+				}
+				resp.Mappings = append(resp.Mappings, mapping)
+				if len(resp.Mappings) == limit {
+					nextCursor, err := proto.Marshal(&MappingCursor{
+						ChainId:          chainID,
+						LastForeignLocal: mapping.Foreign.Local,
+					})
+					if err != nil {
+						return nil, err
+					}
+					resp.NextCursor = nextCursor
+					return resp, nil
+				}
+			}
+		}
+	}
+	return resp, nil
+}
+
+// GetMappingCount returns the number of mappings to accounts on the given foreign chain, or the
+// total number of mappings if no chain ID is specified.
+func GetMappingCount(ctx contract.StaticContext, req *GetMappingCountRequest) (*GetMappingCountResponse, error) {
+	if req.ChainId != "" {
+		count, err := loadMappingCount(ctx, req.ChainId)
+		if err != nil {
+			return nil, err
+		}
+		return &GetMappingCountResponse{Count: count}, nil
+	}
+
+	counts, err := loadMappingCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var total uint64
+	for _, count := range counts {
+		total += count.Count
+	}
+	return &GetMappingCountResponse{Count: total}, nil
+}
+
+// BuildMappingIndex adds all the existing mappings to the mapping index, mappings that are already
+// indexed are skipped.
+func BuildMappingIndex(ctx contract.Context) error {
+	for _, item := range ctx.Range([]byte(AddressPrefix)) {
+		var mapping amtypes.AddressMapperMapping
+		if err := proto.Unmarshal(item.Value, &mapping); err != nil {
+			return errors.Wrap(err, "unmarshal mapping")
+		}
+		err := indexMapping(ctx, loom.UnmarshalAddressPB(mapping.From), loom.UnmarshalAddressPB(mapping.To))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
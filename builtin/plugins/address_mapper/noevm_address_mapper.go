@@ -4,6 +4,7 @@ package address_mapper
 
 import (
 	"crypto/ecdsa"
+	"errors"
 
 	"github.com/loomnetwork/go-loom"
 	amtypes "github.com/loomnetwork/go-loom/builtin/types/address_mapper"
@@ -47,4 +48,16 @@ func SignIdentityMapping(_, _ loom.Address, _ *ecdsa.PrivateKey, _ evmcompat.Sig
 	return nil, nil
 }
 
+func ListMappingPage(_ contract.StaticContext, _ *ListMappingPageRequest) (*ListMappingPageResponse, error) {
+	return nil, errors.New("not implemented in non-EVM build")
+}
+
+func GetMappingCount(_ contract.StaticContext, _ *GetMappingCountRequest) (*GetMappingCountResponse, error) {
+	return nil, errors.New("not implemented in non-EVM build")
+}
+
+func LoadSessionKey(_ contract.StaticContext, _ loom.Address) (*SessionKey, error) {
+	return nil, nil
+}
+
 var Contract plugin.Contract = contract.MakePluginContract(&AddressMapper{})
@@ -0,0 +1,241 @@
+// +build evm
+
+package address_mapper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/features"
+	ssha "github.com/miguelmota/go-solidity-sha3"
+	"github.com/pkg/errors"
+)
+
+// SESSION KEYS
+//
+// An account can authorize a session key to sign txs on its behalf, e.g. so a game can send txs
+// without prompting the user to sign each one with their main wallet. When a tx signed by a session
+// key is processed by the MultiChainSignatureTxMiddleware the tx origin & sender are resolved to
+// the account that authorized the session key (the owner), as long as the session key hasn't
+// expired, the tx calls one of the contracts (and methods) the session key is allowed to call, and
+// the session key hasn't used up its tx budget.
+//
+// The session key must sign SessionKeyAuthHash(owner, sessionKey) to prove that the owner actually
+// controls it, otherwise an account could claim someone else's key as its own session key.
+
+const (
+	SessionKeyAddedEventTopic   = "addressmapper:sessionkeyadded"
+	SessionKeyRemovedEventTopic = "addressmapper:sessionkeyremoved"
+
+	maxSessionKeysPerOwner     = 32
+	maxSessionKeyContracts     = 32
+	maxSessionKeyMethods       = 64
+	sessionKeyAuthHashTypeName = "LoomSessionKey"
+)
+
+var (
+	// ErrSessionKeyNotFound indicates that the session key hasn't been authorized by any account.
+	ErrSessionKeyNotFound = errors.New("[Address Mapper] session key not found")
+	// ErrSessionKeyInUse indicates that the session key has already been authorized by another account.
+	ErrSessionKeyInUse = errors.New("[Address Mapper] session key already authorized by another account")
+
+	errSessionKeysDisabled = errors.New("[Address Mapper] session keys are not enabled")
+
+	SessionKeyPrefix      = "session-key"
+	OwnerSessionKeyPrefix = "owner-session-key"
+)
+
+func sessionKeyKey(sessionKey loom.Address) []byte {
+	return util.PrefixKey([]byte(SessionKeyPrefix), sessionKey.Bytes())
+}
+
+func ownerSessionKeysPrefix(owner loom.Address) []byte {
+	return util.PrefixKey([]byte(OwnerSessionKeyPrefix), owner.Bytes())
+}
+
+func ownerSessionKeyKey(owner, sessionKey loom.Address) []byte {
+	return util.PrefixKey(ownerSessionKeysPrefix(owner), sessionKey.Bytes())
+}
+
+// AddSessionKey authorizes a session key to sign txs on behalf of the caller, if the session key
+// has already been authorized by the caller its permissions & tx budget are reset.
+// Session keys can't be allowed to call the Address Mapper itself.
+func (am *AddressMapper) AddSessionKey(ctx contract.Context, req *AddSessionKeyRequest) error {
+	if !ctx.FeatureEnabled(features.AddressMapperVersion1_5, false) {
+		return errSessionKeysDisabled
+	}
+	if req.SessionKey == nil || len(req.AllowedContracts) == 0 ||
+		len(req.AllowedContracts) > maxSessionKeyContracts ||
+		len(req.AllowedMethods) > maxSessionKeyMethods {
+		return ErrInvalidRequest
+	}
+	for _, addr := range req.AllowedContracts {
+		if addr == nil {
+			return ErrInvalidRequest
+		}
+		// A session key that could call the Address Mapper would be able to add or revoke session
+		// keys, or change the mappings of the owner account.
+		if loom.UnmarshalAddressPB(addr).Compare(ctx.ContractAddress()) == 0 {
+			return errors.Wrap(ErrInvalidRequest, "session keys can't be allowed to call the Address Mapper")
+		}
+	}
+
+	owner := ctx.Message().Sender
+	sessionKey := loom.UnmarshalAddressPB(req.SessionKey)
+	if sessionKey.Compare(owner) == 0 {
+		return errors.Wrap(ErrInvalidRequest, "session key must differ from the owner account")
+	}
+	now := uint64(ctx.Now().Unix())
+	if req.ExpiresAt <= now {
+		return errors.Wrap(ErrInvalidRequest, "session key expiry must be in the future")
+	}
+
+	signerAddr, err := recoverSignerAddress(
+		sessionKey.ChainID, SessionKeyAuthHash(owner, sessionKey), req.Signature,
+		getAllowedSignatureTypes(ctx),
+	)
+	if err != nil {
+		return errors.Wrap(err, ErrNotAuthorized.Error())
+	}
+	if !bytes.Equal(signerAddr, sessionKey.Local) {
+		return errors.Wrapf(
+			ErrNotAuthorized, "signer address doesn't match, %s != %s",
+			signerAddr.String(), sessionKey.Local.String(),
+		)
+	}
+
+	existing, err := LoadSessionKey(ctx, sessionKey)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if loom.UnmarshalAddressPB(existing.Owner).Compare(owner) != 0 {
+			return ErrSessionKeyInUse
+		}
+	} else if len(ctx.Range(ownerSessionKeysPrefix(owner))) >= maxSessionKeysPerOwner {
+		return errors.Wrap(ErrInvalidRequest, "too many session keys")
+	}
+
+	record := &SessionKey{
+		Owner:            owner.MarshalPB(),
+		SessionKey:       req.SessionKey,
+		ExpiresAt:        req.ExpiresAt,
+		AllowedContracts: req.AllowedContracts,
+		AllowedMethods:   req.AllowedMethods,
+		TxBudget:         req.TxBudget,
+		CreatedAt:        now,
+	}
+	if err := ctx.Set(sessionKeyKey(sessionKey), record); err != nil {
+		return err
+	}
+	if err := ctx.Set(ownerSessionKeyKey(owner, sessionKey), req.SessionKey); err != nil {
+		return err
+	}
+	return emitEvent(ctx, &SessionKeyAddedEvent{SessionKey: record}, SessionKeyAddedEventTopic)
+}
+
+// RemoveSessionKey revokes a session key previously authorized by the caller.
+func (am *AddressMapper) RemoveSessionKey(ctx contract.Context, req *RemoveSessionKeyRequest) error {
+	if !ctx.FeatureEnabled(features.AddressMapperVersion1_5, false) {
+		return errSessionKeysDisabled
+	}
+	if req.SessionKey == nil {
+		return ErrInvalidRequest
+	}
+
+	owner := ctx.Message().Sender
+	sessionKey := loom.UnmarshalAddressPB(req.SessionKey)
+	record, err := LoadSessionKey(ctx, sessionKey)
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return ErrSessionKeyNotFound
+	}
+	if loom.UnmarshalAddressPB(record.Owner).Compare(owner) != 0 {
+		return ErrNotAuthorized
+	}
+
+	ctx.Delete(sessionKeyKey(sessionKey))
+	ctx.Delete(ownerSessionKeyKey(owner, sessionKey))
+	return emitEvent(ctx, &SessionKeyRemovedEvent{
+		Owner:      owner.MarshalPB(),
+		SessionKey: req.SessionKey,
+	}, SessionKeyRemovedEventTopic)
+}
+
+// GetSessionKey returns the authorization record of a session key.
+func (am *AddressMapper) GetSessionKey(
+	ctx contract.StaticContext, req *GetSessionKeyRequest,
+) (*GetSessionKeyResponse, error) {
+	if req.SessionKey == nil {
+		return nil, ErrInvalidRequest
+	}
+	record, err := LoadSessionKey(ctx, loom.UnmarshalAddressPB(req.SessionKey))
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, ErrSessionKeyNotFound
+	}
+	return &GetSessionKeyResponse{SessionKey: record}, nil
+}
+
+// ListSessionKeys returns the session keys authorized by an account, including expired ones.
+func (am *AddressMapper) ListSessionKeys(
+	ctx contract.StaticContext, req *ListSessionKeysRequest,
+) (*ListSessionKeysResponse, error) {
+	if req.Owner == nil {
+		return nil, ErrInvalidRequest
+	}
+	owner := loom.UnmarshalAddressPB(req.Owner)
+	items := ctx.Range(ownerSessionKeysPrefix(owner))
+	sessionKeys := make([]*SessionKey, 0, len(items))
+	for _, item := range items {
+		var addr types.Address
+		if err := proto.Unmarshal(item.Value, &addr); err != nil {
+			return nil, errors.Wrap(err, "unmarshal session key address")
+		}
+		record, err := LoadSessionKey(ctx, loom.UnmarshalAddressPB(&addr))
+		if err != nil {
+			return nil, err
+		}
+		if record != nil {
+			sessionKeys = append(sessionKeys, record)
+		}
+	}
+	sort.Slice(sessionKeys, func(i, j int) bool {
+		return loom.UnmarshalAddressPB(sessionKeys[i].SessionKey).Compare(
+			loom.UnmarshalAddressPB(sessionKeys[j].SessionKey)) < 0
+	})
+	return &ListSessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+// LoadSessionKey returns the authorization record of a session key, or nil if the session key
+// hasn't been authorized by any account.
+func LoadSessionKey(ctx contract.StaticContext, sessionKey loom.Address) (*SessionKey, error) {
+	var record SessionKey
+	if err := ctx.Get(sessionKeyKey(sessionKey), &record); err != nil {
+		if err == contract.ErrNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "[Address Mapper] failed to load session key %v", sessionKey)
+	}
+	return &record, nil
+}
+
+// SessionKeyAuthHash returns the hash that must be signed by a session key to authorize it to sign
+// txs on behalf of the owner account.
+func SessionKeyAuthHash(owner, sessionKey loom.Address) []byte {
+	return ssha.SoliditySHA3(
+		ssha.String(sessionKeyAuthHashTypeName),
+		ssha.Address(common.BytesToAddress(owner.Local)),
+		ssha.Address(common.BytesToAddress(sessionKey.Local)),
+	)
+}
@@ -12,6 +12,7 @@ import (
 	plugintypes "github.com/loomnetwork/go-loom/plugin/types"
 	"github.com/loomnetwork/go-loom/util"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/config/settings"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/registry"
 	"github.com/pkg/errors"
@@ -439,6 +440,15 @@ func (c *ChainConfig) SetSetting(ctx contract.Context, req *SetSettingRequest) e
 		return ErrNotAuthorized
 	}
 
+	if ctx.FeatureEnabled(features.ChainCfgVersion1_5, false) {
+		featureEnabled := func(name string) bool {
+			return ctx.FeatureEnabled(name, false)
+		}
+		if err := settings.Validate(req.Name, req.Value, featureEnabled); err != nil {
+			return errors.Wrap(ErrInvalidRequest, err.Error())
+		}
+	}
+
 	action := &Action{
 		Name:        req.Name,
 		Value:       req.Value,
@@ -515,22 +525,93 @@ func getCurrentValidators(ctx contract.StaticContext) ([]loom.Address, error) {
 	}
 
 	validatorsList := ctx.Validators()
-	chainID := ctx.Block().ChainID
-
 	if len(validatorsList) == 0 {
 		return nil, ErrEmptyValidatorsList
 	}
 
-	validators := make([]loom.Address, 0, len(validatorsList))
+	pubKeys := make([][]byte, 0, len(validatorsList))
 	for _, v := range validatorsList {
 		if v != nil {
-			address := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(v.PubKey)}
-			validators = append(validators, address)
+			pubKeys = append(pubKeys, v.PubKey)
 		}
 	}
+	return resolveValidatorAddresses(ctx, pubKeys)
+}
+
+// Returns the address of the validator each of the given validator keys belongs to. Once DPOS v3.18
+// is enabled validators can rotate their keys, so the validator address may no longer be derived
+// from the validator key, in which case the DPOSv3 contract is used to look up the address.
+func resolveValidatorAddresses(ctx contract.StaticContext, pubKeys [][]byte) ([]loom.Address, error) {
+	chainID := ctx.Block().ChainID
+	if !ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+		validators := make([]loom.Address, 0, len(pubKeys))
+		for _, pubKey := range pubKeys {
+			validators = append(validators, loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(pubKey)})
+		}
+		return validators, nil
+	}
+
+	contractAddr, err := ctx.Resolve("dposV3")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve address of DPOSv3 contract")
+	}
+	req := &dposv3.ResolveValidatorKeysRequest{PubKeys: pubKeys}
+	var resp dposv3.ResolveValidatorKeysResponse
+	if err := contract.StaticCallMethod(ctx, contractAddr, "ResolveValidatorKeys", req, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to call ResolveValidatorKeys")
+	}
+	if len(resp.Candidates) != len(pubKeys) {
+		return nil, errors.New("failed to resolve validator keys")
+	}
+
+	validators := make([]loom.Address, 0, len(resp.Candidates))
+	for _, addr := range resp.Candidates {
+		validators = append(validators, loom.UnmarshalAddressPB(addr))
+	}
 	return validators, nil
 }
 
+// Returns the address of the current validator the sender of the tx signs for. Validators sign txs
+// with their current validator key, so if a validator has rotated its key the sender address will
+// differ from the validator address.
+func getSenderValidator(ctx contract.StaticContext) (loom.Address, error) {
+	sender := ctx.Message().Sender
+	if !ctx.FeatureEnabled(features.ChainCfgVersion1_1, false) {
+		validators, err := getCurrentValidatorsFromDPOS(ctx)
+		if err != nil {
+			return loom.Address{}, err
+		}
+		for _, v := range validators {
+			if sender.Compare(v) == 0 {
+				return sender, nil
+			}
+		}
+		return loom.Address{}, ErrNotAuthorized
+	}
+
+	validatorsList := ctx.Validators()
+	if len(validatorsList) == 0 {
+		return loom.Address{}, ErrEmptyValidatorsList
+	}
+
+	chainID := ctx.Block().ChainID
+	for _, v := range validatorsList {
+		if v == nil {
+			continue
+		}
+		signer := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(v.PubKey)}
+		if sender.Compare(signer) != 0 {
+			continue
+		}
+		validators, err := resolveValidatorAddresses(ctx, [][]byte{v.PubKey})
+		if err != nil {
+			return loom.Address{}, err
+		}
+		return validators[0], nil
+	}
+	return loom.Address{}, ErrNotAuthorized
+}
+
 func getFeature(ctx contract.StaticContext, name string, curValidators []loom.Address) (*Feature, error) {
 	var feature Feature
 	if err := ctx.Get(featureKey(name), &feature); err != nil {
@@ -594,22 +675,10 @@ func enableFeature(ctx contract.Context, name string) error {
 	}
 
 	// check if this is a called from validator
-	curValidators, err := getCurrentValidators(ctx)
+	sender, err := getSenderValidator(ctx)
 	if err != nil {
 		return err
 	}
-	sender := ctx.Message().Sender
-
-	found := false
-	for _, v := range curValidators {
-		if sender.Compare(v) == 0 {
-			found = true
-			break
-		}
-	}
-	if !found {
-		return ErrNotAuthorized
-	}
 
 	// record the fact that the validator is ready to enable the feature
 	var feature Feature
@@ -684,21 +753,10 @@ func (c *ChainConfig) SetValidatorInfo(ctx contract.Context, req *SetValidatorIn
 	if !ctx.FeatureEnabled(features.ChainCfgVersion1_2, false) {
 		return ErrFeatureNotEnabled
 	}
-	senderAddr := ctx.Message().Sender
-	validators, err := getCurrentValidators(ctx)
+	senderAddr, err := getSenderValidator(ctx)
 	if err != nil {
 		return err
 	}
-	isValidator := false
-	for _, validator := range validators {
-		if validator.Compare(senderAddr) == 0 {
-			isValidator = true
-			break
-		}
-	}
-	if !isValidator {
-		return ErrNotAuthorized
-	}
 
 	validator := &ValidatorInfo{
 		Address:     senderAddr.MarshalPB(),
@@ -24,6 +24,7 @@ var (
 	pubKey2                                            = "JHFJjkpXUJLuTTl+kOJ3I6EA1TnKtIOUxo7uPGlcPTQ="
 	pubKey3                                            = "l/xG3rd63kAzflA2hMQgKq3CDDuKzseXIzAc/MS8FPI="
 	pubKey4                                            = "umC8MrxDsffG9153juF61840dDCEIrhKVxyI72UkoSw="
+	rotatedPubKey1                                     = "0FluIfx52G4d2JBNdI/sgZ8Xsxu69qGhrOj1pgrEtXk="
 	pubKeyB64_1, pubKeyB64_2, pubKeyB64_3, pubKeyB64_4 []byte
 )
 
@@ -672,4 +673,155 @@ func (c *ChainConfigTestSuite) TestCfgSettingFourValidators() {
 	})
 	require.NoError(err)
 
+	// Unknown settings & out of range values are accepted until settings validation is enabled
+	err = chainconfigContract.SetSetting(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &SetSettingRequest{
+		Name:        actionName,
+		BuildNumber: 200,
+		Value:       "1000000",
+	})
+	require.NoError(err)
+
+	pctx.SetFeature(features.ChainCfgVersion1_5, true)
+	err = chainconfigContract.SetSetting(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &SetSettingRequest{
+		Name:        actionName,
+		BuildNumber: 200,
+		Value:       "1000000",
+	})
+	require.Error(err)
+	err = chainconfigContract.SetSetting(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &SetSettingRequest{
+		Name:        "AppStore.Unknown",
+		BuildNumber: 200,
+		Value:       actionValue,
+	})
+	require.Error(err)
+	err = chainconfigContract.SetSetting(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &SetSettingRequest{
+		Name:        actionName,
+		BuildNumber: 200,
+		Value:       actionValue,
+	})
+	require.NoError(err)
+
+}
+
+func (c *ChainConfigTestSuite) TestFeatureFlagRotatedValidatorKey() {
+	require := c.Require()
+	featureName := "hardfork"
+	encoder := base64.StdEncoding
+	pubKeyB64_1, _ = encoder.DecodeString(pubKey1)
+	addr1 := loom.Address{ChainID: "", Local: loom.LocalAddressFromPublicKey(pubKeyB64_1)}
+	pubKeyB64_2, _ = encoder.DecodeString(pubKey2)
+	addr2 := loom.Address{ChainID: "", Local: loom.LocalAddressFromPublicKey(pubKeyB64_2)}
+	rotatedPubKey, _ := encoder.DecodeString(rotatedPubKey1)
+	rotatedAddr1 := loom.Address{ChainID: "", Local: loom.LocalAddressFromPublicKey(rotatedPubKey)}
+
+	pctx := plugin.CreateFakeContext(addr1, addr1)
+	pctx.SetFeature(features.ChainCfgVersion1_1, true)
+	pctx.SetFeature(features.DPOSVersion3_18, true)
+	validators := []*loom.Validator{
+		&loom.Validator{
+			PubKey: pubKeyB64_1,
+			Power:  10,
+		},
+		&loom.Validator{
+			PubKey: pubKeyB64_2,
+			Power:  10,
+		},
+	}
+	pctx = pctx.WithValidators(validators)
+
+	//Init fake coin contract
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	err := coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{},
+	})
+	require.NoError(err)
+
+	//Init fake dposv3 contract
+	dposv3Contract := dposv3.DPOS{}
+	dposv3Addr := pctx.CreateContract(dposv3.Contract)
+	pctx = pctx.WithAddress(dposv3Addr)
+	ctx := contractpb.WrapPluginContext(pctx)
+
+	err = dposv3Contract.Init(ctx, &dposv3.InitRequest{
+		Params: &dposv3.Params{
+			ValidatorCount: 21,
+		},
+		Validators:     validators,
+		InitCandidates: true,
+	})
+	require.NoError(err)
+
+	chainconfigContract := &ChainConfig{}
+	err = chainconfigContract.Init(ctx, &InitRequest{
+		Owner: addr1.MarshalPB(),
+		Params: &Params{
+			VoteThreshold:         66,
+			NumBlockConfirmations: 10,
+		},
+	})
+	require.NoError(err)
+
+	err = chainconfigContract.AddFeature(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &AddFeatureRequest{
+		Names: []string{featureName},
+	})
+	require.NoError(err)
+
+	err = chainconfigContract.EnableFeature(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &EnableFeatureRequest{
+		Names: []string{featureName},
+	})
+	require.NoError(err)
+
+	// rotate the key of the first validator, and replace it in the validator set
+	err = dposv3Contract.RotateValidatorKey(
+		contractpb.WrapPluginContext(pctx.WithSender(addr1)),
+		&dposv3.RotateValidatorKeyRequest{NewPubKey: rotatedPubKey},
+	)
+	require.NoError(err)
+	require.NoError(dposv3.Elect(ctx))
+	pctx = pctx.WithValidators([]*loom.Validator{
+		&loom.Validator{
+			PubKey: rotatedPubKey,
+			Power:  10,
+		},
+		&loom.Validator{
+			PubKey: pubKeyB64_2,
+			Power:  10,
+		},
+	})
+
+	// the vote cast with the old key still counts for the validator
+	getFeature, err := chainconfigContract.GetFeature(contractpb.WrapPluginContext(pctx.WithSender(addr2)), &GetFeatureRequest{
+		Name: featureName,
+	})
+	require.NoError(err)
+	require.Equal(uint64(50), getFeature.Feature.Percentage)
+
+	// the old key is no longer a validator key, and the new key signs for the same validator
+	err = chainconfigContract.EnableFeature(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &EnableFeatureRequest{
+		Names: []string{featureName},
+	})
+	require.Equal(ErrNotAuthorized, err)
+	err = chainconfigContract.EnableFeature(contractpb.WrapPluginContext(pctx.WithSender(rotatedAddr1)), &EnableFeatureRequest{
+		Names: []string{featureName},
+	})
+	require.Equal(ErrFeatureAlreadyEnabled, err)
+
+	err = chainconfigContract.EnableFeature(contractpb.WrapPluginContext(pctx.WithSender(addr2)), &EnableFeatureRequest{
+		Names: []string{featureName},
+	})
+	require.NoError(err)
+	getFeature, err = chainconfigContract.GetFeature(contractpb.WrapPluginContext(pctx.WithSender(addr2)), &GetFeatureRequest{
+		Name: featureName,
+	})
+	require.NoError(err)
+	require.Equal(uint64(100), getFeature.Feature.Percentage)
+	require.Equal(2, len(getFeature.Feature.Validators))
+
+	// the DPOS contract refuses the old key once the rotation has taken effect
+	staticCtx := contractpb.WrapPluginStaticContext(pctx)
+	require.Error(dposv3.CheckValidatorKeyActive(staticCtx, pubKeyB64_1))
+	require.NoError(dposv3.CheckValidatorKeyActive(staticCtx, rotatedPubKey))
+	require.NoError(dposv3.CheckValidatorKeyActive(staticCtx, pubKeyB64_2))
 }
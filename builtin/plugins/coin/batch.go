@@ -0,0 +1,54 @@
+package coin
+
+import (
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// MaxBatchTransferSize is the max number of transfers that can be made in a single batch transfer.
+const MaxBatchTransferSize = 500
+
+// BatchTransfer transfers coins from the caller to multiple recipients, either all the transfers
+// succeed or none of them do. A transfer event is emitted for each recipient.
+func (c *Coin) BatchTransfer(ctx contract.Context, req *BatchTransferRequest) error {
+	if err := ValidateBatchTransfer(ctx, req); err != nil {
+		return err
+	}
+	for _, entry := range req.Transfers {
+		if err := c.transfer(ctx, &TransferRequest{To: entry.To, Amount: entry.Amount}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateBatchTransfer checks that a batch transfer is well formed, and that the caller's balance
+// covers the total amount of the batch. This is shared by the Coin & ETHCoin contracts.
+func ValidateBatchTransfer(ctx contract.StaticContext, req *BatchTransferRequest) error {
+	if !ctx.FeatureEnabled(features.CoinVersion1_6Feature, false) {
+		return errors.New("[Coin Contract] batch transfers are not enabled")
+	}
+	if len(req.Transfers) == 0 || len(req.Transfers) > MaxBatchTransferSize {
+		return errors.Wrapf(ErrInvalidRequest, "batch must contain between 1 and %d transfers", MaxBatchTransferSize)
+	}
+
+	total := loom.NewBigUIntFromInt(0)
+	for _, entry := range req.Transfers {
+		if entry == nil || entry.To == nil || entry.Amount == nil || entry.Amount.Value.Int == nil {
+			return ErrInvalidRequest
+		}
+		total.Add(total, &entry.Amount.Value)
+	}
+
+	var acct Account
+	err := ctx.Get(accountKey(ctx.Message().Sender), &acct)
+	if err != nil && err != contract.ErrNotFound {
+		return err
+	}
+	if acct.Balance == nil || acct.Balance.Value.Int == nil || acct.Balance.Value.Cmp(total) < 0 {
+		return ErrSenderBalanceTooLow
+	}
+	return nil
+}
@@ -55,7 +55,7 @@ var (
 )
 
 func accountKey(addr loom.Address) []byte {
-	return util.PrefixKey([]byte("account"), addr.Bytes())
+	return util.PrefixKey(accountKeyPrefix, addr.Bytes())
 }
 
 func allowanceKey(owner, spender loom.Address) []byte {
@@ -88,8 +88,7 @@ func (c *Coin) Init(ctx contract.Context, req *InitRequest) error {
 				Value: *balance,
 			},
 		}
-		err := ctx.Set(accountKey(owner), acct)
-		if err != nil {
+		if err := saveAccount(ctx, acct); err != nil {
 			return err
 		}
 
@@ -184,6 +183,9 @@ func burn(ctx contract.Context, from loom.Address, amount *loom.BigUInt) error {
 	if err := emitTransferEvent(ctx, from, burnAddress, amount); err != nil {
 		return err
 	}
+	if err := RecordTransfer(ctx, from, burnAddress, amount); err != nil {
+		return err
+	}
 
 	return ctx.Set(economyKey, econ)
 }
@@ -220,6 +222,9 @@ func mint(ctx contract.Context, to loom.Address, amount *loom.BigUInt) error {
 	if err := emitTransferEvent(ctx, mintAddress, to, amount); err != nil {
 		return err
 	}
+	if err := RecordTransfer(ctx, mintAddress, to, amount); err != nil {
+		return err
+	}
 
 	return ctx.Set(economyKey, econ)
 }
@@ -304,7 +309,10 @@ func (c *Coin) transfer(ctx contract.Context, req *TransferRequest) error {
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, &amount)
+	if err := emitTransferEvent(ctx, from, to, &amount); err != nil {
+		return err
+	}
+	return RecordTransfer(ctx, from, to, &amount)
 }
 
 func (c *Coin) Approve(ctx contract.Context, req *ApproveRequest) error {
@@ -420,7 +428,10 @@ func (c *Coin) transferFrom(ctx contract.Context, req *TransferFromRequest) erro
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, &amount)
+	if err := emitTransferEvent(ctx, from, to, &amount); err != nil {
+		return err
+	}
+	return RecordTransfer(ctx, from, to, &amount)
 }
 
 func loadAccount(
@@ -443,7 +454,10 @@ func loadAccount(
 
 func saveAccount(ctx contract.Context, acct *Account) error {
 	owner := loom.UnmarshalAddressPB(acct.Owner)
-	return ctx.Set(accountKey(owner), acct)
+	if err := ctx.Set(accountKey(owner), acct); err != nil {
+		return err
+	}
+	return UpdateHolderIndex(ctx, acct)
 }
 
 func loadAllowance(
@@ -511,7 +525,10 @@ func (c *Coin) legacyTransfer(ctx contract.Context, req *TransferRequest) error
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, &amount)
+	if err := emitTransferEvent(ctx, from, to, &amount); err != nil {
+		return err
+	}
+	return RecordTransfer(ctx, from, to, &amount)
 }
 
 func (c *Coin) legacyTransferFrom(ctx contract.Context, req *TransferFromRequest) error {
@@ -565,7 +582,10 @@ func (c *Coin) legacyTransferFrom(ctx contract.Context, req *TransferFromRequest
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, &amount)
+	if err := emitTransferEvent(ctx, from, to, &amount); err != nil {
+		return err
+	}
+	return RecordTransfer(ctx, from, to, &amount)
 }
 
 // Events
@@ -591,6 +611,12 @@ func emitTransferEvent(ctx contract.Context, from, spender loom.Address, amount
 }
 
 func emitApprovalEvent(ctx contract.Context, from, to loom.Address, amount *loom.BigUInt) error {
+	return emitApprovalEventWithTopic(ctx, ApprovalEventTopic, from, to, amount)
+}
+
+func emitApprovalEventWithTopic(
+	ctx contract.Context, topic string, from, to loom.Address, amount *loom.BigUInt,
+) error {
 	var safeAmount *types.BigUInt
 	if amount == nil {
 		safeAmount = loom.BigZeroPB()
@@ -606,6 +632,6 @@ func emitApprovalEvent(ctx contract.Context, from, to loom.Address, amount *loom
 		return err
 	}
 
-	ctx.EmitTopics(marshalled, ApprovalEventTopic)
+	ctx.EmitTopics(marshalled, topic)
 	return nil
 }
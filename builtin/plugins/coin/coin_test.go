@@ -1,13 +1,19 @@
 package coin
 
 import (
+	"bytes"
+	"crypto/ecdsa"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common/evmcompat"
 	"github.com/loomnetwork/go-loom/plugin"
 	"github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/types"
@@ -669,3 +675,339 @@ func TestNilRequest(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestPermit(t *testing.T) {
+	contract := &Coin{}
+	now := time.Now()
+	pctx := plugin.CreateFakeContext(addr3, addr2).
+		WithBlock(loom.BlockHeader{ChainID: "chain", Time: now.Unix()})
+	ctx := contractpb.WrapPluginContext(pctx)
+
+	ownerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ownerLocal, err := loom.LocalAddressFromHexString(crypto.PubkeyToAddress(ownerKey.PublicKey).Hex())
+	require.NoError(t, err)
+	owner := loom.Address{ChainID: "eth", Local: ownerLocal}
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	require.NoError(t, saveAccount(ctx, &Account{
+		Owner:   owner.MarshalPB(),
+		Balance: &types.BigUInt{Value: *loom.NewBigUIntFromInt(100)},
+	}))
+
+	amount := loom.NewBigUIntFromInt(40)
+	deadline := uint64(now.Add(time.Hour).Unix())
+	makePermit := func(nonce, deadline uint64, key *ecdsa.PrivateKey) *PermitRequest {
+		sig, err := SignPermit(
+			"chain", addr2, owner, addr3, amount, nonce, deadline, key, evmcompat.SignatureType_EIP712,
+		)
+		require.NoError(t, err)
+		return &PermitRequest{
+			Owner:     owner.MarshalPB(),
+			Spender:   addr3.MarshalPB(),
+			Amount:    &types.BigUInt{Value: *amount},
+			Nonce:     nonce,
+			Deadline:  deadline,
+			Signature: sig,
+		}
+	}
+
+	// permits are disabled until Coin v1.4
+	require.Error(t, contract.Permit(ctx, makePermit(0, deadline, ownerKey)))
+
+	pctx.SetFeature(features.CoinVersion1_4Feature, true)
+	ctx = contractpb.WrapPluginContext(pctx)
+
+	require.Equal(t, ErrPermitExpired, contract.Permit(ctx, makePermit(0, uint64(now.Unix()-1), ownerKey)))
+	require.Equal(t, ErrInvalidPermitNonce, contract.Permit(ctx, makePermit(1, deadline, ownerKey)))
+	require.Equal(t, ErrInvalidPermitSig, contract.Permit(ctx, makePermit(0, deadline, otherKey)))
+
+	permit := makePermit(0, deadline, ownerKey)
+	require.NoError(t, contract.Permit(ctx, permit))
+	// a permit can only be used once
+	require.Equal(t, ErrInvalidPermitNonce, contract.Permit(ctx, permit))
+
+	nonceResp, err := contract.PermitNonce(ctx, &PermitNonceRequest{Owner: owner.MarshalPB()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonceResp.Nonce)
+
+	allowResp, err := contract.Allowance(ctx, &AllowanceRequest{
+		Owner:   owner.MarshalPB(),
+		Spender: addr3.MarshalPB(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 40, int(allowResp.Amount.Value.Int64()))
+
+	require.NoError(t, contract.TransferFrom(ctx, &TransferFromRequest{
+		From:   owner.MarshalPB(),
+		To:     addr3.MarshalPB(),
+		Amount: &types.BigUInt{Value: *amount},
+	}))
+	balResp, err := contract.BalanceOf(ctx, &BalanceOfRequest{Owner: addr3.MarshalPB()})
+	require.NoError(t, err)
+	assert.Equal(t, 40, int(balResp.Balance.Value.Int64()))
+
+	// the permit is bound to the chain ID of the owner
+	permit = makePermit(1, deadline, ownerKey)
+	permit.Owner = loom.Address{ChainID: "tron", Local: owner.Local}.MarshalPB()
+	require.Equal(t, ErrInvalidPermitSig, contract.Permit(ctx, permit))
+
+	// the spender can apply a permit & transfer in a single tx
+	transferReq := &TransferFromWithPermitRequest{
+		Permit: makePermit(1, deadline, ownerKey),
+		To:     addr1.MarshalPB(),
+		Amount: &types.BigUInt{Value: *loom.NewBigUIntFromInt(30)},
+	}
+	require.Error(t, contract.TransferFromWithPermit(
+		contractpb.WrapPluginContext(pctx.WithSender(addr1)), transferReq,
+	))
+	require.NoError(t, contract.TransferFromWithPermit(ctx, transferReq))
+	balResp, err = contract.BalanceOf(ctx, &BalanceOfRequest{Owner: addr1.MarshalPB()})
+	require.NoError(t, err)
+	assert.Equal(t, 30, int(balResp.Balance.Value.Int64()))
+	allowResp, err = contract.Allowance(ctx, &AllowanceRequest{
+		Owner:   owner.MarshalPB(),
+		Spender: addr3.MarshalPB(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10, int(allowResp.Amount.Value.Int64()))
+	require.Equal(t, ErrInvalidPermitNonce, contract.TransferFromWithPermit(ctx, transferReq))
+}
+
+func TestHoldersAndTransferHistory(t *testing.T) {
+	contract := &Coin{}
+	pctx := plugin.CreateFakeContext(addr1, addr1)
+	pctx.SetFeature(features.CoinVersion1_1Feature, true)
+	pctx.SetFeature(features.CoinVersion1_5Feature, true)
+	ctx := contractpb.WrapPluginContext(pctx)
+
+	require.NoError(t, contract.Init(ctx, &InitRequest{
+		Accounts: []*InitialAccount{
+			{Owner: addr1.MarshalPB(), Balance: 100},
+			{Owner: addr2.MarshalPB(), Balance: 0},
+		},
+	}))
+
+	amount := loom.NewBigUIntFromInt(10)
+	for _, to := range []loom.Address{addr2, addr3, addr2} {
+		require.NoError(t, contract.Transfer(ctx, &TransferRequest{
+			To:     to.MarshalPB(),
+			Amount: &types.BigUInt{Value: *amount},
+		}))
+	}
+
+	// holders are ordered by address: addr3 < addr1 < addr2
+	holdersResp, err := contract.ListHolders(ctx, &ListHoldersRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, holdersResp.Holders, 2)
+	assert.Equal(t, addr3.String(), loom.UnmarshalAddressPB(holdersResp.Holders[0].Owner).String())
+	assert.Equal(t, addr1.String(), loom.UnmarshalAddressPB(holdersResp.Holders[1].Owner).String())
+	require.NotEmpty(t, holdersResp.NextCursor)
+	holdersResp, err = contract.ListHolders(ctx, &ListHoldersRequest{Cursor: holdersResp.NextCursor, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, holdersResp.Holders, 1)
+	assert.Equal(t, addr2.String(), loom.UnmarshalAddressPB(holdersResp.Holders[0].Owner).String())
+	assert.Empty(t, holdersResp.NextCursor)
+
+	topResp, err := contract.TopHolders(ctx, &TopHoldersRequest{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), topResp.Total)
+	require.Len(t, topResp.Holders, 2)
+	assert.Equal(t, addr1.String(), loom.UnmarshalAddressPB(topResp.Holders[0].Owner).String())
+	assert.Equal(t, addr2.String(), loom.UnmarshalAddressPB(topResp.Holders[1].Owner).String())
+	require.NotEmpty(t, topResp.NextCursor)
+	topResp, err = contract.TopHolders(ctx, &TopHoldersRequest{Cursor: topResp.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, topResp.Holders, 1)
+	assert.Equal(t, addr3.String(), loom.UnmarshalAddressPB(topResp.Holders[0].Owner).String())
+	assert.Empty(t, topResp.NextCursor)
+
+	historyResp, err := contract.TransferHistory(ctx, &TransferHistoryRequest{Owner: addr1.MarshalPB(), Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, historyResp.Transfers, 2)
+	assert.Equal(t, uint64(3), historyResp.Transfers[0].Seq)
+	assert.Equal(t, addr2.String(), loom.UnmarshalAddressPB(historyResp.Transfers[0].To).String())
+	assert.Equal(t, addr3.String(), loom.UnmarshalAddressPB(historyResp.Transfers[1].To).String())
+	assert.Equal(t, uint64(2), historyResp.NextCursor)
+	historyResp, err = contract.TransferHistory(ctx, &TransferHistoryRequest{
+		Owner:  addr1.MarshalPB(),
+		Cursor: historyResp.NextCursor,
+	})
+	require.NoError(t, err)
+	require.Len(t, historyResp.Transfers, 1)
+	assert.Equal(t, uint64(1), historyResp.Transfers[0].Seq)
+	assert.Equal(t, uint64(0), historyResp.NextCursor)
+
+	historyResp, err = contract.TransferHistory(ctx, &TransferHistoryRequest{Owner: addr2.MarshalPB()})
+	require.NoError(t, err)
+	require.Len(t, historyResp.Transfers, 2)
+	assert.Equal(t, 10, int(historyResp.Transfers[0].Amount.Value.Int64()))
+}
+
+func TestBuildHolderIndex(t *testing.T) {
+	contract := &Coin{}
+	pctx := plugin.CreateFakeContext(addr1, addr1)
+	pctx.SetFeature(features.CoinVersion1_1Feature, true)
+	ctx := contractpb.WrapPluginContext(pctx)
+
+	// accounts created before the holder index is enabled
+	require.NoError(t, contract.Init(ctx, &InitRequest{
+		Accounts: []*InitialAccount{
+			{Owner: addr1.MarshalPB(), Balance: 100},
+			{Owner: addr2.MarshalPB(), Balance: 50},
+		},
+	}))
+
+	_, err := contract.ListHolders(ctx, &ListHoldersRequest{})
+	require.Equal(t, errHolderIndexDisabled, err)
+	require.Error(t, BuildHolderIndex(ctx))
+
+	pctx.SetFeature(features.CoinVersion1_5Feature, true)
+	topResp, err := contract.TopHolders(ctx, &TopHoldersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), topResp.Total)
+	assert.Len(t, topResp.Holders, 0)
+
+	require.NoError(t, BuildHolderIndex(ctx))
+	// building the index again shouldn't change anything
+	require.NoError(t, BuildHolderIndex(ctx))
+
+	topResp, err = contract.TopHolders(ctx, &TopHoldersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), topResp.Total)
+	require.Len(t, topResp.Holders, 2)
+	assert.Equal(t, addr1.String(), loom.UnmarshalAddressPB(topResp.Holders[0].Owner).String())
+	assert.Equal(t, addr2.String(), loom.UnmarshalAddressPB(topResp.Holders[1].Owner).String())
+
+	// moving the whole balance of an account should move the recipient up the balance index, and
+	// remove the sender from the holder indexes
+	balResp, err := contract.BalanceOf(ctx, &BalanceOfRequest{Owner: addr1.MarshalPB()})
+	require.NoError(t, err)
+	require.NoError(t, contract.Transfer(ctx, &TransferRequest{
+		To:     addr2.MarshalPB(),
+		Amount: balResp.Balance,
+	}))
+
+	topResp, err = contract.TopHolders(ctx, &TopHoldersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), topResp.Total)
+	require.Len(t, topResp.Holders, 1)
+	assert.Equal(t, addr2.String(), loom.UnmarshalAddressPB(topResp.Holders[0].Owner).String())
+	assert.Equal(t, "150000000000000000000", topResp.Holders[0].Balance.Value.String())
+
+	holdersResp, err := contract.ListHolders(ctx, &ListHoldersRequest{})
+	require.NoError(t, err)
+	require.Len(t, holdersResp.Holders, 1)
+	assert.Equal(t, addr2.String(), loom.UnmarshalAddressPB(holdersResp.Holders[0].Owner).String())
+	assert.Empty(t, holdersResp.NextCursor)
+}
+
+func TestTopHolderSortKey(t *testing.T) {
+	large := loom.NewBigUInt(new(big.Int).Lsh(big.NewInt(1), 200))
+	keys := [][]byte{
+		topHolderSortKey(addr2, large),
+		topHolderSortKey(addr1, loom.NewBigUIntFromInt(256)),
+		topHolderSortKey(addr2, loom.NewBigUIntFromInt(256)),
+		topHolderSortKey(addr1, loom.NewBigUIntFromInt(255)),
+		topHolderSortKey(addr1, loom.NewBigUIntFromInt(1)),
+	}
+	// keys are ordered by balance (highest first), and then by address
+	for i := 1; i < len(keys); i++ {
+		assert.True(t, bytes.Compare(keys[i-1], keys[i]) < 0, "key %d should come before key %d", i-1, i)
+	}
+}
+
+func TestTransferHistoryPruning(t *testing.T) {
+	contract := &Coin{}
+	pctx := plugin.CreateFakeContext(addr1, addr1)
+	pctx.SetFeature(features.CoinVersion1_1Feature, true)
+	pctx.SetFeature(features.CoinVersion1_5Feature, true)
+	ctx := contractpb.WrapPluginContext(pctx)
+
+	require.NoError(t, contract.Init(ctx, &InitRequest{
+		Accounts: []*InitialAccount{
+			{Owner: addr1.MarshalPB(), Balance: 1000},
+		},
+	}))
+
+	numTransfers := maxTransferHistory + 5
+	for i := 1; i <= numTransfers; i++ {
+		require.NoError(t, contract.Transfer(ctx, &TransferRequest{
+			To:     addr2.MarshalPB(),
+			Amount: &types.BigUInt{Value: *loom.NewBigUIntFromInt(int64(i))},
+		}))
+	}
+
+	// the oldest transfers should've been deleted
+	for seq := uint64(1); seq <= 5; seq++ {
+		assert.False(t, ctx.Has(transferHistoryKey(addr1, seq)))
+		assert.False(t, ctx.Has(transferHistoryKey(addr2, seq)))
+	}
+
+	historyResp, err := contract.TransferHistory(ctx, &TransferHistoryRequest{
+		Owner: addr1.MarshalPB(),
+		Limit: maxTransferHistory - 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, historyResp.Transfers, maxTransferHistory-1)
+	assert.Equal(t, uint64(numTransfers), historyResp.Transfers[0].Seq)
+	require.NotZero(t, historyResp.NextCursor)
+
+	historyResp, err = contract.TransferHistory(ctx, &TransferHistoryRequest{
+		Owner:  addr1.MarshalPB(),
+		Cursor: historyResp.NextCursor,
+	})
+	require.NoError(t, err)
+	require.Len(t, historyResp.Transfers, 1)
+	assert.Equal(t, uint64(6), historyResp.Transfers[0].Seq)
+	assert.Equal(t, 6, int(historyResp.Transfers[0].Amount.Value.Int64()))
+	assert.Equal(t, uint64(0), historyResp.NextCursor)
+}
+
+func TestBatchTransfer(t *testing.T) {
+	contract := &Coin{}
+	pctx := plugin.CreateFakeContext(addr1, addr1)
+	pctx.SetFeature(features.CoinVersion1_1Feature, true)
+	ctx := contractpb.WrapPluginContext(pctx)
+
+	require.NoError(t, saveAccount(ctx, &Account{
+		Owner:   addr1.MarshalPB(),
+		Balance: &types.BigUInt{Value: *loom.NewBigUIntFromInt(100)},
+	}))
+
+	batch := func(amounts ...int64) *BatchTransferRequest {
+		req := &BatchTransferRequest{}
+		for i, amount := range amounts {
+			to := addr2
+			if i%2 == 1 {
+				to = addr3
+			}
+			req.Transfers = append(req.Transfers, &BatchTransferEntry{
+				To:     to.MarshalPB(),
+				Amount: &types.BigUInt{Value: *loom.NewBigUIntFromInt(amount)},
+			})
+		}
+		return req
+	}
+	balanceOf := func(addr loom.Address) int64 {
+		resp, err := contract.BalanceOf(ctx, &BalanceOfRequest{Owner: addr.MarshalPB()})
+		require.NoError(t, err)
+		return resp.Balance.Value.Int64()
+	}
+
+	// batch transfers are disabled until Coin v1.6
+	require.Error(t, contract.BatchTransfer(ctx, batch(10, 20)))
+
+	pctx.SetFeature(features.CoinVersion1_6Feature, true)
+	ctx = contractpb.WrapPluginContext(pctx)
+
+	require.Equal(t, ErrInvalidRequest, errors.Cause(contract.BatchTransfer(ctx, batch())))
+	require.Equal(t, ErrSenderBalanceTooLow, contract.BatchTransfer(ctx, batch(50, 60)))
+	assert.Equal(t, int64(100), balanceOf(addr1))
+
+	require.NoError(t, contract.BatchTransfer(ctx, batch(10, 20, 30)))
+	assert.Equal(t, int64(40), balanceOf(addr1))
+	assert.Equal(t, int64(40), balanceOf(addr2))
+	assert.Equal(t, int64(20), balanceOf(addr3))
+}
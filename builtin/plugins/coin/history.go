@@ -0,0 +1,432 @@
+package coin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// HOLDERS & TRANSFER HISTORY
+//
+// When coin:v1.5 is enabled every account with a non-zero balance is stored in two holder indexes,
+// which are updated whenever the balance of an account changes. The address index is split into 256
+// buckets (by the first byte of the holder's address), so that a page of holders can be loaded
+// without loading all the accounts. The balance index is keyed by the bitwise complement of the
+// holder's balance as a fixed-width big-endian number, followed by the holder's address, so the
+// order of the keys matches the order of the holders (highest balance first), and a page can be
+// read from a cursor by comparing keys without decoding the balances of the holders before it.
+// The number of holders is tracked alongside the indexes.
+//
+// Accounts that were created before coin:v1.5 was enabled are indexed by BuildHolderIndex, which
+// is run via a migration.
+//
+// When coin:v1.5 is enabled every transfer (including mints & burns) is also recorded in the
+// transfer history of the sender & the recipient, transfers made before that aren't available.
+// Each history entry is keyed by a per-account sequence number so a page of history can be loaded
+// without loading the whole history of the account. Only the most recent maxTransferHistory
+// transfers of each account are retained, older entries are deleted as new ones are recorded.
+//
+// These helpers are shared by the Coin & ETHCoin contracts, both of which store their accounts
+// under the same prefix.
+
+const (
+	defaultPageSize       = 100
+	maxPageSize           = 1000
+	numHolderIndexBuckets = 256
+	// Size of the fixed-width balance in the balance index keys.
+	topHolderBalanceSize = 32
+	// Number of transfers retained in the transfer history of each account.
+	maxTransferHistory = 100
+)
+
+var (
+	accountKeyPrefix         = []byte("account")
+	holderIndexPrefix        = []byte("holder-idx")
+	topHolderIndexPrefix     = []byte("top-holder-idx")
+	holderCountKey           = []byte("holder-count")
+	transferHistoryKeyPrefix = []byte("transfer-history")
+	transferCountKeyPrefix   = []byte("transfer-count")
+
+	errHolderIndexDisabled = errors.New("holder index is not enabled")
+)
+
+func holderIndexBucketKey(bucket byte) []byte {
+	return util.PrefixKey(holderIndexPrefix, []byte{bucket})
+}
+
+func holderIndexKey(owner loom.Address) []byte {
+	return util.PrefixKey(holderIndexBucketKey(holderIndexBucket(owner)), owner.Bytes())
+}
+
+func holderIndexBucket(owner loom.Address) byte {
+	if len(owner.Local) == 0 {
+		return 0
+	}
+	return owner.Local[0]
+}
+
+func topHolderIndexKey(owner loom.Address, balance *loom.BigUInt) []byte {
+	return util.PrefixKey(topHolderIndexPrefix, topHolderSortKey(owner, balance))
+}
+
+// topHolderSortKey returns the key of a holder in the balance index (without the index prefix).
+// Balances of more than 256 bits are capped, so such holders are only ordered by address.
+func topHolderSortKey(owner loom.Address, balance *loom.BigUInt) []byte {
+	ownerBytes := owner.Bytes()
+	key := make([]byte, topHolderBalanceSize, topHolderBalanceSize+len(ownerBytes))
+	if balance.BitLen() <= topHolderBalanceSize*8 {
+		balanceBytes := balance.Bytes()
+		copy(key[topHolderBalanceSize-len(balanceBytes):], balanceBytes)
+		for i := range key {
+			key[i] = ^key[i]
+		}
+	}
+	return append(key, ownerBytes...)
+}
+
+func transferHistoryKey(owner loom.Address, seq uint64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, seq)
+	return util.PrefixKey(transferHistoryKeyPrefix, owner.Bytes(), seqBytes)
+}
+
+func transferCountKey(owner loom.Address) []byte {
+	return util.PrefixKey(transferCountKeyPrefix, owner.Bytes())
+}
+
+func pageSize(limit uint32) int {
+	if limit == 0 {
+		return defaultPageSize
+	}
+	if limit > maxPageSize {
+		return maxPageSize
+	}
+	return int(limit)
+}
+
+// ListHolders returns a page of accounts with a non-zero balance, see GetHolderPage.
+func (c *Coin) ListHolders(ctx contract.StaticContext, req *ListHoldersRequest) (*ListHoldersResponse, error) {
+	return GetHolderPage(ctx, req)
+}
+
+// TopHolders returns a page of accounts with a non-zero balance, ordered by balance (highest first).
+func (c *Coin) TopHolders(ctx contract.StaticContext, req *TopHoldersRequest) (*TopHoldersResponse, error) {
+	return GetTopHolders(ctx, req)
+}
+
+// TransferHistory returns a page of transfers to & from an account, newest first.
+func (c *Coin) TransferHistory(
+	ctx contract.StaticContext, req *TransferHistoryRequest,
+) (*TransferHistoryResponse, error) {
+	return GetTransferHistory(ctx, req)
+}
+
+func hasBalance(balance *types.BigUInt) bool {
+	return balance != nil && balance.Value.Int != nil && balance.Value.Sign() != 0
+}
+
+// UpdateHolderIndex updates the holder indexes after the balance of an account has changed, it's a
+// no-op unless coin:v1.5 is enabled. Accounts with a zero balance are removed from the indexes.
+func UpdateHolderIndex(ctx contract.Context, acct *Account) error {
+	if !ctx.FeatureEnabled(features.CoinVersion1_5Feature, false) {
+		return nil
+	}
+	return indexHolder(ctx, acct)
+}
+
+func indexHolder(ctx contract.Context, acct *Account) error {
+	owner := loom.UnmarshalAddressPB(acct.Owner)
+	key := holderIndexKey(owner)
+	var prev Holder
+	err := ctx.Get(key, &prev)
+	if err != nil && err != contract.ErrNotFound {
+		return errors.Wrapf(err, "failed to load holder %v", owner)
+	}
+	indexed := err == nil
+	if indexed {
+		if hasBalance(acct.Balance) && prev.Balance.Value.Cmp(&acct.Balance.Value) == 0 {
+			return nil
+		}
+		ctx.Delete(topHolderIndexKey(owner, &prev.Balance.Value))
+	}
+
+	if !hasBalance(acct.Balance) {
+		if !indexed {
+			return nil
+		}
+		ctx.Delete(key)
+		return adjustHolderCount(ctx, -1)
+	}
+
+	holder := &Holder{Owner: acct.Owner, Balance: acct.Balance}
+	if err := ctx.Set(key, holder); err != nil {
+		return err
+	}
+	if err := ctx.Set(topHolderIndexKey(owner, &acct.Balance.Value), holder); err != nil {
+		return err
+	}
+	if !indexed {
+		return adjustHolderCount(ctx, 1)
+	}
+	return nil
+}
+
+func loadHolderCount(ctx contract.StaticContext) (uint64, error) {
+	var count HolderCount
+	if err := ctx.Get(holderCountKey, &count); err != nil && err != contract.ErrNotFound {
+		return 0, errors.Wrap(err, "failed to load holder count")
+	}
+	return count.Count, nil
+}
+
+func adjustHolderCount(ctx contract.Context, delta int64) error {
+	count, err := loadHolderCount(ctx)
+	if err != nil {
+		return err
+	}
+	if delta < 0 && count < uint64(-delta) {
+		return errors.New("holder count can't be negative")
+	}
+	return ctx.Set(holderCountKey, &HolderCount{Count: uint64(int64(count) + delta)})
+}
+
+// loadHolderIndexBucket returns the holders in a single bucket of one of the holder indexes.
+func loadHolderIndexBucket(ctx contract.StaticContext, bucketKey []byte) ([]*Holder, error) {
+	items := ctx.Range(bucketKey)
+	holders := make([]*Holder, 0, len(items))
+	for _, item := range items {
+		var holder Holder
+		if err := proto.Unmarshal(item.Value, &holder); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal holder")
+		}
+		holders = append(holders, &holder)
+	}
+	return holders, nil
+}
+
+func decodeHolderCursor(cursorBytes []byte) (*HolderCursor, error) {
+	if len(cursorBytes) == 0 {
+		return nil, nil
+	}
+	var cursor HolderCursor
+	if err := proto.Unmarshal(cursorBytes, &cursor); err != nil || cursor.Owner == nil {
+		return nil, errors.Wrap(ErrInvalidRequest, "invalid cursor")
+	}
+	return &cursor, nil
+}
+
+// GetHolderPage returns a page of accounts with a non-zero balance from the address index, ordered
+// by the first byte of the address, and then by address.
+func GetHolderPage(ctx contract.StaticContext, req *ListHoldersRequest) (*ListHoldersResponse, error) {
+	if !ctx.FeatureEnabled(features.CoinVersion1_5Feature, false) {
+		return nil, errHolderIndexDisabled
+	}
+	cursor, err := decodeHolderCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	startBucket := 0
+	var lastOwner *loom.Address
+	if cursor != nil {
+		owner := loom.UnmarshalAddressPB(cursor.Owner)
+		startBucket = int(holderIndexBucket(owner))
+		lastOwner = &owner
+	}
+
+	limit := pageSize(req.Limit)
+	resp := &ListHoldersResponse{}
+	for bucket := startBucket; bucket < numHolderIndexBuckets; bucket++ {
+		holders, err := loadHolderIndexBucket(ctx, holderIndexBucketKey(byte(bucket)))
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(holders, func(i, j int) bool {
+			return loom.UnmarshalAddressPB(holders[i].Owner).Compare(loom.UnmarshalAddressPB(holders[j].Owner)) < 0
+		})
+		for _, holder := range holders {
+			if lastOwner != nil && loom.UnmarshalAddressPB(holder.Owner).Compare(*lastOwner) <= 0 {
+				continue
+			}
+			resp.Holders = append(resp.Holders, holder)
+			if len(resp.Holders) == limit {
+				nextCursor, err := proto.Marshal(&HolderCursor{Owner: holder.Owner})
+				if err != nil {
+					return nil, err
+				}
+				resp.NextCursor = nextCursor
+				return resp, nil
+			}
+		}
+		// the cursor only applies to the bucket the page started in
+		lastOwner = nil
+	}
+	return resp, nil
+}
+
+// GetTopHolders returns a page of accounts with a non-zero balance from the balance index, ordered
+// by balance (highest first), accounts with the same balance are ordered by address.
+func GetTopHolders(ctx contract.StaticContext, req *TopHoldersRequest) (*TopHoldersResponse, error) {
+	if !ctx.FeatureEnabled(features.CoinVersion1_5Feature, false) {
+		return nil, errHolderIndexDisabled
+	}
+	cursor, err := decodeHolderCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if cursor != nil && !hasBalance(cursor.Balance) {
+		return nil, errors.Wrap(ErrInvalidRequest, "invalid cursor")
+	}
+
+	total, err := loadHolderCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := ctx.Range(topHolderIndexPrefix)
+	sort.Slice(items, func(i, j int) bool {
+		return bytes.Compare(items[i].Key, items[j].Key) < 0
+	})
+	start := 0
+	if cursor != nil {
+		cursorKey := topHolderSortKey(loom.UnmarshalAddressPB(cursor.Owner), &cursor.Balance.Value)
+		start = sort.Search(len(items), func(i int) bool {
+			return bytes.Compare(items[i].Key, cursorKey) > 0
+		})
+	}
+
+	limit := pageSize(req.Limit)
+	resp := &TopHoldersResponse{Total: total}
+	for _, item := range items[start:] {
+		var holder Holder
+		if err := proto.Unmarshal(item.Value, &holder); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal holder")
+		}
+		resp.Holders = append(resp.Holders, &holder)
+		if len(resp.Holders) == limit {
+			nextCursor, err := proto.Marshal(&HolderCursor{Owner: holder.Owner, Balance: holder.Balance})
+			if err != nil {
+				return nil, err
+			}
+			resp.NextCursor = nextCursor
+			return resp, nil
+		}
+	}
+	return resp, nil
+}
+
+// BuildHolderIndex adds all the existing accounts with a non-zero balance to the holder indexes,
+// accounts that are already indexed are skipped. Since the indexes are only kept up to date while
+// coin:v1.5 is enabled this must not be run before the feature is enabled.
+func BuildHolderIndex(ctx contract.Context) error {
+	if !ctx.FeatureEnabled(features.CoinVersion1_5Feature, false) {
+		return errors.Errorf("%s feature is not enabled", features.CoinVersion1_5Feature)
+	}
+	for _, item := range ctx.Range(accountKeyPrefix) {
+		var acct Account
+		if err := proto.Unmarshal(item.Value, &acct); err != nil {
+			return errors.Wrap(err, "failed to unmarshal account")
+		}
+		if acct.Owner == nil {
+			continue
+		}
+		if err := indexHolder(ctx, &acct); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTransferHistory returns a page of transfers to & from an account, newest first.
+func GetTransferHistory(ctx contract.StaticContext, req *TransferHistoryRequest) (*TransferHistoryResponse, error) {
+	if req.Owner == nil {
+		return nil, ErrInvalidRequest
+	}
+	owner := loom.UnmarshalAddressPB(req.Owner)
+	count, err := loadTransferCount(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the most recent transfers are retained
+	oldestSeq := uint64(1)
+	if count > maxTransferHistory {
+		oldestSeq = count - maxTransferHistory + 1
+	}
+	seq := count
+	if req.Cursor > 0 && req.Cursor-1 < seq {
+		seq = req.Cursor - 1
+	}
+	limit := pageSize(req.Limit)
+	resp := &TransferHistoryResponse{}
+	for ; seq >= oldestSeq && len(resp.Transfers) < limit; seq-- {
+		var record TransferRecord
+		if err := ctx.Get(transferHistoryKey(owner, seq), &record); err != nil {
+			return nil, errors.Wrapf(err, "failed to load transfer %d of %v", seq, owner)
+		}
+		resp.Transfers = append(resp.Transfers, &record)
+	}
+	if seq >= oldestSeq {
+		resp.NextCursor = seq + 1
+	}
+	return resp, nil
+}
+
+func loadTransferCount(ctx contract.StaticContext, owner loom.Address) (uint64, error) {
+	var state TransferHistoryState
+	if err := ctx.Get(transferCountKey(owner), &state); err != nil && err != contract.ErrNotFound {
+		return 0, errors.Wrapf(err, "failed to load transfer count of %v", owner)
+	}
+	return state.Count, nil
+}
+
+// RecordTransfer adds a transfer to the transfer history of the sender & the recipient, and deletes
+// the oldest transfer from the history of any account that exceeds maxTransferHistory transfers.
+// It's a no-op unless coin:v1.5 is enabled. Mints & burns are only recorded in the history of the
+// recipient and the sender respectively.
+func RecordTransfer(ctx contract.Context, from, to loom.Address, amount *loom.BigUInt) error {
+	if !ctx.FeatureEnabled(features.CoinVersion1_5Feature, false) {
+		return nil
+	}
+
+	record := &TransferRecord{
+		From:        from.MarshalPB(),
+		To:          to.MarshalPB(),
+		Amount:      loom.BigZeroPB(),
+		BlockHeight: uint64(ctx.Block().Height),
+		BlockTime:   ctx.Block().Time,
+	}
+	if amount != nil {
+		record.Amount = &types.BigUInt{Value: *amount}
+	}
+
+	rootAddr := loom.RootAddress(ctx.Block().ChainID)
+	for i, owner := range []loom.Address{from, to} {
+		if owner.Compare(rootAddr) == 0 || (i == 1 && to.Compare(from) == 0) {
+			continue
+		}
+		count, err := loadTransferCount(ctx, owner)
+		if err != nil {
+			return err
+		}
+		record.Seq = count + 1
+		if err := ctx.Set(transferHistoryKey(owner, record.Seq), record); err != nil {
+			return err
+		}
+		if err := ctx.Set(transferCountKey(owner), &TransferHistoryState{Count: record.Seq}); err != nil {
+			return err
+		}
+		if record.Seq > maxTransferHistory {
+			ctx.Delete(transferHistoryKey(owner, record.Seq-maxTransferHistory))
+		}
+	}
+	return nil
+}
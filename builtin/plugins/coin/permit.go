@@ -0,0 +1,224 @@
+package coin
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	loom "github.com/loomnetwork/go-loom"
+	amtypes "github.com/loomnetwork/go-loom/builtin/types/address_mapper"
+	"github.com/loomnetwork/go-loom/common/evmcompat"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/features"
+	ssha "github.com/miguelmota/go-solidity-sha3"
+	"github.com/pkg/errors"
+)
+
+// PERMITS
+//
+// A permit is an approval signed by the owner of an account that can be submitted to the contract
+// by anyone, so a dapp (or a relayer) can set an allowance & transfer tokens from the owner's
+// account without the owner having to send an Approve tx first (similar to EIP-2612). The spender
+// can use TransferFromWithPermit to apply a permit & transfer the tokens in a single tx.
+//
+// The owner signs PermitHash(...) with their Ethereum key, the owner must either be an Ethereum
+// account, or a DAppChain account mapped to an Ethereum account via the Address Mapper.
+// Each permit includes the owner's current permit nonce, which is incremented whenever a permit is
+// used, so a permit can only be used once.
+
+const (
+	addressMapperContractName = "addressmapper"
+	permitHashTypeName        = "LoomCoinPermit"
+)
+
+var (
+	ErrPermitExpired      = errors.New("[Coin Contract] permit expired")
+	ErrInvalidPermitNonce = errors.New("[Coin Contract] invalid permit nonce")
+	ErrInvalidPermitSig   = errors.New("[Coin Contract] invalid permit signature")
+
+	permitSigTypes = []evmcompat.SignatureType{
+		evmcompat.SignatureType_EIP712,
+		evmcompat.SignatureType_GETH,
+		evmcompat.SignatureType_TREZOR,
+	}
+)
+
+func permitNonceKey(owner loom.Address) []byte {
+	return util.PrefixKey([]byte("permit-nonce"), owner.Bytes())
+}
+
+// Permit sets the allowance of the spender to the amount specified in a permit signed by the owner.
+func (c *Coin) Permit(ctx contract.Context, req *PermitRequest) error {
+	return ApplyPermit(ctx, req, ApprovalEventTopic)
+}
+
+// TransferFromWithPermit sets the allowance of the caller to the amount specified in a permit
+// signed by the owner, and then transfers tokens from the owner's account to the given account.
+func (c *Coin) TransferFromWithPermit(ctx contract.Context, req *TransferFromWithPermitRequest) error {
+	transferReq, err := ApplyTransferPermit(ctx, req, ApprovalEventTopic)
+	if err != nil {
+		return err
+	}
+	return c.TransferFrom(ctx, transferReq)
+}
+
+// PermitNonce returns the nonce that must be included in the next permit signed by the owner.
+func (c *Coin) PermitNonce(ctx contract.StaticContext, req *PermitNonceRequest) (*PermitNonceResponse, error) {
+	if req.Owner == nil {
+		return nil, ErrInvalidRequest
+	}
+	nonce, err := GetPermitNonce(ctx, loom.UnmarshalAddressPB(req.Owner))
+	if err != nil {
+		return nil, err
+	}
+	return &PermitNonceResponse{Nonce: nonce}, nil
+}
+
+// GetPermitNonce returns the nonce that must be included in the next permit signed by the owner.
+func GetPermitNonce(ctx contract.StaticContext, owner loom.Address) (uint64, error) {
+	var state PermitState
+	if err := ctx.Get(permitNonceKey(owner), &state); err != nil && err != contract.ErrNotFound {
+		return 0, errors.Wrapf(err, "failed to load permit nonce of %v", owner)
+	}
+	return state.Nonce, nil
+}
+
+// ApplyPermit validates the given permit, increments the permit nonce of the owner, and sets the
+// allowance of the spender. This is shared by the Coin & ETHCoin contracts, which store allowances
+// in the same way, approvalEventTopic is the topic of the approval event emitted by the contract.
+func ApplyPermit(ctx contract.Context, req *PermitRequest, approvalEventTopic string) error {
+	if err := usePermit(ctx, req); err != nil {
+		return err
+	}
+
+	owner := loom.UnmarshalAddressPB(req.Owner)
+	spender := loom.UnmarshalAddressPB(req.Spender)
+	allow, err := loadAllowance(ctx, owner, spender)
+	if err != nil {
+		return err
+	}
+	allow.Amount = req.Amount
+	if err := saveAllowance(ctx, allow); err != nil {
+		return err
+	}
+	return emitApprovalEventWithTopic(ctx, approvalEventTopic, owner, spender, &req.Amount.Value)
+}
+
+// ApplyTransferPermit applies the permit in the given request (see ApplyPermit), and returns the
+// request the contract should pass to TransferFrom to complete the transfer. The caller must be
+// the spender the permit was signed for, since TransferFrom spends the allowance of the caller.
+func ApplyTransferPermit(
+	ctx contract.Context, req *TransferFromWithPermitRequest, approvalEventTopic string,
+) (*TransferFromRequest, error) {
+	if req.Permit == nil || req.To == nil || req.Amount == nil {
+		return nil, ErrInvalidRequest
+	}
+	if req.Permit.Spender == nil ||
+		loom.UnmarshalAddressPB(req.Permit.Spender).Compare(ctx.Message().Sender) != 0 {
+		return nil, errors.Wrap(ErrInvalidRequest, "caller must be the spender of the permit")
+	}
+	if err := ApplyPermit(ctx, req.Permit, approvalEventTopic); err != nil {
+		return nil, err
+	}
+	return &TransferFromRequest{
+		From:   req.Permit.Owner,
+		To:     req.To,
+		Amount: req.Amount,
+	}, nil
+}
+
+// usePermit validates the given permit and increments the permit nonce of the owner, it's up to the
+// caller to actually set the allowance.
+func usePermit(ctx contract.Context, req *PermitRequest) error {
+	if !ctx.FeatureEnabled(features.CoinVersion1_4Feature, false) {
+		return errors.New("[Coin Contract] permits are not enabled")
+	}
+	if req == nil || req.Owner == nil || req.Spender == nil || req.Amount == nil || len(req.Signature) == 0 {
+		return ErrInvalidRequest
+	}
+
+	if uint64(ctx.Now().Unix()) > req.Deadline {
+		return ErrPermitExpired
+	}
+
+	owner := loom.UnmarshalAddressPB(req.Owner)
+	nonce, err := GetPermitNonce(ctx, owner)
+	if err != nil {
+		return err
+	}
+	if req.Nonce != nonce {
+		return ErrInvalidPermitNonce
+	}
+
+	hash := PermitHash(
+		ctx.Block().ChainID, ctx.ContractAddress(), owner, loom.UnmarshalAddressPB(req.Spender),
+		&req.Amount.Value, req.Nonce, req.Deadline,
+	)
+	signer, err := evmcompat.RecoverAddressFromTypedSig(hash, req.Signature, permitSigTypes)
+	if err != nil {
+		return errors.Wrap(err, ErrInvalidPermitSig.Error())
+	}
+	if err := verifyPermitSigner(ctx, owner, signer.Bytes()); err != nil {
+		return err
+	}
+
+	return ctx.Set(permitNonceKey(owner), &PermitState{Nonce: nonce + 1})
+}
+
+// verifyPermitSigner checks that the Ethereum account that signed a permit is either the owner
+// itself, or the Ethereum account the owner is mapped to.
+func verifyPermitSigner(ctx contract.StaticContext, owner loom.Address, signer []byte) error {
+	if owner.ChainID != ctx.Block().ChainID {
+		if bytes.Equal(owner.Local, signer) {
+			return nil
+		}
+		return ErrInvalidPermitSig
+	}
+
+	addressMapper, err := ctx.Resolve(addressMapperContractName)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve Address Mapper")
+	}
+	var resp amtypes.AddressMapperGetMappingResponse
+	err = contract.StaticCallMethod(ctx, addressMapper, "GetMapping", &amtypes.AddressMapperGetMappingRequest{
+		From: owner.MarshalPB(),
+	}, &resp)
+	if err != nil {
+		return errors.Wrap(err, ErrInvalidPermitSig.Error())
+	}
+	if resp.To == nil || !bytes.Equal(resp.To.Local, signer) {
+		return ErrInvalidPermitSig
+	}
+	return nil
+}
+
+// PermitHash returns the hash that must be signed by the owner to permit the spender to transfer up
+// to the given amount from the owner's account. The chain IDs of the owner & spender are included
+// so a permit for an account on one chain can't be used for an account on another chain with the
+// same local address.
+func PermitHash(
+	chainID string, contractAddr, owner, spender loom.Address, amount *loom.BigUInt, nonce, deadline uint64,
+) []byte {
+	return ssha.SoliditySHA3(
+		ssha.String(permitHashTypeName),
+		ssha.String(chainID),
+		ssha.Address(common.BytesToAddress(contractAddr.Local)),
+		ssha.String(owner.ChainID),
+		ssha.Address(common.BytesToAddress(owner.Local)),
+		ssha.String(spender.ChainID),
+		ssha.Address(common.BytesToAddress(spender.Local)),
+		ssha.Uint256(amount.Int),
+		ssha.Uint64(nonce),
+		ssha.Uint64(deadline),
+	)
+}
+
+// SignPermit signs a permit with the given Ethereum key.
+func SignPermit(
+	chainID string, contractAddr, owner, spender loom.Address, amount *loom.BigUInt, nonce, deadline uint64,
+	key *ecdsa.PrivateKey, sigType evmcompat.SignatureType,
+) ([]byte, error) {
+	hash := PermitHash(chainID, contractAddr, owner, spender, amount, nonce, deadline)
+	return evmcompat.GenerateTypedSig(hash, key, sigType)
+}
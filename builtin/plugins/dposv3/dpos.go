@@ -54,6 +54,18 @@ const (
 	DelegatorUnbondsEventTopic       = "dposv3:delegatorunbonds"
 	ReferrerRegistersEventTopic      = "dposv3:referrerregisters"
 	DelegatorClaimsRewardsEventTopic = "dposv3:delegatorclaimsrewards"
+	LiquidDelegatesEventTopic        = "dposv3:liquiddelegates"
+	LiquidRedeemsEventTopic          = "dposv3:liquidredeems"
+	LiquidTransferEventTopic         = "dposv3:liquidtransfer"
+	LiquidApprovalEventTopic         = "dposv3:liquidapproval"
+	DelegatorCompoundsEventTopic     = "dposv3:delegatorcompounds"
+	CandidateFeeLimitsEventTopic     = "dposv3:candidatefeelimits"
+	FeeChangeScheduledEventTopic     = "dposv3:feechangescheduled"
+	FeeChangeAppliedEventTopic       = "dposv3:feechangeapplied"
+	RedelegationQueuedEventTopic     = "dposv3:redelegationqueued"
+	ProposalSubmittedEventTopic      = "dposv3:proposalsubmitted"
+	ProposalTalliedEventTopic        = "dposv3:proposaltallied"
+	ValidatorKeyRotatedEventTopic    = "dposv3:validatorkeyrotated"
 )
 
 var (
@@ -382,6 +394,7 @@ func (c *DPOS) Redelegate(ctx contract.Context, req *RedelegateRequest) error {
 	}
 
 	// Allow oracle to specify the delegator
+	oracleRedelegation := false
 	if req.DelegatorAddress != nil && ctx.FeatureEnabled(features.DPOSVersion3_10, false) {
 		state, err := LoadState(ctx)
 		if err != nil {
@@ -394,6 +407,7 @@ func (c *DPOS) Redelegate(ctx contract.Context, req *RedelegateRequest) error {
 		}
 
 		delegator = loom.UnmarshalAddressPB(req.DelegatorAddress)
+		oracleRedelegation = true
 	}
 
 	priorDelegation, err := GetDelegation(ctx, req.Index, *req.FormerValidatorAddress, *delegator.MarshalPB())
@@ -405,6 +419,17 @@ func (c *DPOS) Redelegate(ctx contract.Context, req *RedelegateRequest) error {
 		return err
 	}
 
+	// Redelegations requested by the oracle aren't subject to the redelegation limits
+	if ctx.FeatureEnabled(features.DPOSVersion3_16, false) && !oracleRedelegation {
+		return queueRedelegation(ctx, priorDelegation, req)
+	}
+
+	return c.applyRedelegation(ctx, priorDelegation, req)
+}
+
+// applyRedelegation updates the given delegation (and creates a new one if only part of it is being
+// redelegated) so that the stake is moved to the new validator at the end of the next election.
+func (c *DPOS) applyRedelegation(ctx contract.Context, priorDelegation *Delegation, req *RedelegateRequest) error {
 	newLocktimeTier := priorDelegation.LocktimeTier
 	newLocktime := priorDelegation.LockTime
 
@@ -526,6 +551,9 @@ func consolidateDelegations(ctx contract.Context, validator, delegator *types.Ad
 		if err = DeleteDelegation(ctx, delegation); err != nil {
 			return nil, nil, -1, err
 		}
+		if ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+			deleteRedelegationCooldown(ctx, delegation)
+		}
 	}
 
 	index, err := GetNextDelegationIndex(ctx, *validator, *delegator)
@@ -602,11 +630,10 @@ func (c *DPOS) ClaimRewardsFromAllValidators(ctx contract.Context, req *ClaimDel
 	}
 
 	total := big.NewInt(0)
-	chainID := ctx.Block().ChainID
 	var claimedFromValidators []*types.Address
 	var amounts []*types.BigUInt
 	for _, v := range validators {
-		valAddress := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(v.PubKey)}
+		valAddress := candidateAddressFromPubKey(ctx, v.PubKey)
 		delegation, err := GetDelegation(ctx, REWARD_DELEGATION_INDEX, *valAddress.MarshalPB(), *delegator.MarshalPB())
 		if err == contract.ErrNotFound {
 			// Skip reward delegations that were not found.
@@ -713,6 +740,53 @@ func (c *DPOS) claimRewardsFromAllValidators2(
 	}, nil
 }
 
+// SetAutoCompound enables or disables auto-compounding of the rewards earned by the sender's
+// delegations to a validator. While auto-compounding is enabled the rewards each delegation earns
+// during an election are added to the delegation itself, instead of the rewards delegation, so
+// they're locked up along with the rest of the delegation and earn the same tier bonus.
+func (c *DPOS) SetAutoCompound(ctx contract.Context, req *SetAutoCompoundRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_12, false) {
+		return errors.New("DPOS v3.12 is not enabled")
+	}
+
+	delegator := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 SetAutoCompound", "delegator", delegator, "request", req)
+
+	if req.ValidatorAddress == nil {
+		return logDposError(ctx, errors.New("SetAutoCompound called with req.ValidatorAddress == nil"), req.String())
+	}
+
+	validator := loom.UnmarshalAddressPB(req.ValidatorAddress)
+	if req.Enabled {
+		delegations, err := returnMatchingDelegations(ctx, req.ValidatorAddress, delegator.MarshalPB())
+		if err != nil {
+			return err
+		}
+		if len(delegations) == 0 {
+			return logDposError(ctx, errors.New("No delegations to the validator found."), req.String())
+		}
+	}
+
+	return setAutoCompound(ctx, validator, delegator, req.Enabled)
+}
+
+// CheckAutoCompound returns true if auto-compounding is enabled for the delegations from the given
+// delegator to the given validator.
+func (c *DPOS) CheckAutoCompound(ctx contract.StaticContext, req *CheckAutoCompoundRequest) (*CheckAutoCompoundResponse, error) {
+	if req.ValidatorAddress == nil {
+		return nil, errors.New("CheckAutoCompound called with req.ValidatorAddress == nil")
+	}
+
+	delegator := ctx.Message().Sender
+	if req.DelegatorAddress != nil {
+		delegator = loom.UnmarshalAddressPB(req.DelegatorAddress)
+	}
+
+	return &CheckAutoCompoundResponse{
+		Enabled: isAutoCompoundEnabled(ctx, loom.UnmarshalAddressPB(req.ValidatorAddress), delegator),
+	}, nil
+}
+
 func (c *DPOS) Unbond(ctx contract.Context, req *UnbondRequest) error {
 	delegator := ctx.Message().Sender
 	ctx.Logger().Info("DPOSv3 Unbond", "delegator", delegator, "request", req)
@@ -1009,10 +1083,28 @@ func (c *DPOS) RegisterCandidate(ctx contract.Context, req *RegisterCandidateReq
 		return logDposError(ctx, errCandidateAlreadyRegistered, req.String())
 	}
 
+	// Another candidate may have rotated its validator key to this key
+	if ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+		if candidateAddressFromPubKey(ctx, req.PubKey).Compare(candidateAddress) != 0 ||
+			GetCandidateByPubKey(ctx, req.PubKey) != nil {
+			return logDposError(ctx, errors.New("Public key is already in use"), req.String())
+		}
+	}
+
 	if err = validateCandidateFee(ctx, req.Fee); err != nil {
 		return logDposError(ctx, err, req.String())
 	}
 
+	// A candidate that registers again is still bound by the fee limits it committed to
+	fee := req.Fee
+	gradualFeeChangesEnabled := ctx.FeatureEnabled(features.DPOSVersion3_14, false)
+	if gradualFeeChangesEnabled {
+		fee, err = registrationFee(ctx, candidateAddress, req.Fee)
+		if err != nil {
+			return logDposError(ctx, err, req.String())
+		}
+	}
+
 	// validate the maximum referral fee candidate is willing to accept
 	if err = validateFee(req.MaxReferralPercentage); err != nil {
 		return logDposError(ctx, err, req.String())
@@ -1071,14 +1163,19 @@ func (c *DPOS) RegisterCandidate(ctx contract.Context, req *RegisterCandidateReq
 	newCandidate := &Candidate{
 		PubKey:                req.PubKey,
 		Address:               candidateAddress.MarshalPB(),
-		Fee:                   req.Fee,
-		NewFee:                req.Fee,
+		Fee:                   fee,
+		NewFee:                fee,
 		Name:                  req.Name,
 		Description:           req.Description,
 		Website:               req.Website,
 		State:                 REGISTERED,
 		MaxReferralPercentage: req.MaxReferralPercentage,
 	}
+	if gradualFeeChangesEnabled && fee != req.Fee {
+		if err := scheduleFeeChange(ctx, newCandidate, req.Fee); err != nil {
+			return logDposError(ctx, err, req.String())
+		}
+	}
 	candidates.Set(newCandidate)
 
 	if err = saveCandidateList(ctx, candidates); err != nil {
@@ -1141,7 +1238,11 @@ func (c *DPOS) ChangeFee(ctx contract.Context, req *ChangeCandidateFeeRequest) e
 		return errCandidateNotFound
 	}
 
-	if cand.State != REGISTERED {
+	// Once gradual fee changes are enabled a candidate can reschedule a fee change that hasn't been
+	// fully applied yet.
+	gradualFeeChangesEnabled := ctx.FeatureEnabled(features.DPOSVersion3_14, false)
+	if cand.State != REGISTERED &&
+		!(gradualFeeChangesEnabled && (cand.State == ABOUT_TO_CHANGE_FEE || cand.State == CHANGING_FEE)) {
 		return logDposError(ctx, errors.New("Candidate not in REGISTERED state."), req.String())
 	}
 
@@ -1149,8 +1250,14 @@ func (c *DPOS) ChangeFee(ctx contract.Context, req *ChangeCandidateFeeRequest) e
 		return logDposError(ctx, err, req.String())
 	}
 
-	cand.NewFee = req.Fee
-	cand.State = ABOUT_TO_CHANGE_FEE
+	if gradualFeeChangesEnabled {
+		if err := scheduleFeeChange(ctx, cand, req.Fee); err != nil {
+			return logDposError(ctx, err, req.String())
+		}
+	} else {
+		cand.NewFee = req.Fee
+		cand.State = ABOUT_TO_CHANGE_FEE
+	}
 
 	if err = saveCandidateList(ctx, candidates); err != nil {
 		return err
@@ -1326,12 +1433,42 @@ func Elect(ctx contract.Context) error {
 		return nil
 	}
 
+	if ctx.FeatureEnabled(features.DPOSVersion3_17, false) {
+		if err := tallyProposals(ctx, state); err != nil {
+			return err
+		}
+	}
+
+	if ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+		if err := processRedelegationQueue(ctx); err != nil {
+			return err
+		}
+	}
+
 	delegationResults, err := rewardAndSlash(ctx, cachedDelegations, state)
 	if err != nil {
 		return err
 	}
 	ctx.Logger().Debug("DPOSv3 Elect", "delegationResults", len(delegationResults))
 
+	if err := pruneHistory(ctx); err != nil {
+		return err
+	}
+
+	if ctx.FeatureEnabled(features.DPOSVersion3_11, false) {
+		if err := payLiquidRedemptions(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Key rotations must be applied after rewards are distributed to the current validators, but
+	// before the new validator set is elected.
+	if ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+		if err := applyKeyRotations(ctx); err != nil {
+			return err
+		}
+	}
+
 	validatorCount := int(state.Params.ValidatorCount)
 	if len(delegationResults) < validatorCount {
 		validatorCount = len(delegationResults)
@@ -1739,9 +1876,8 @@ func (c *DPOS) DowntimeRecord(ctx contract.StaticContext, req *DowntimeRecordReq
 			return nil, logStaticDposError(ctx, err, req.String())
 		}
 
-		chainID := ctx.Block().ChainID
 		for _, v := range validators {
-			validator := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(v.PubKey)}
+			validator := candidateAddressFromPubKey(ctx, v.PubKey)
 			statistic, err := GetStatistic(ctx, validator)
 			if err != nil {
 				return nil, logStaticDposError(ctx, contract.ErrNotFound, validator.String())
@@ -1779,7 +1915,55 @@ func SlashDoubleSign(ctx contract.Context, statistic *ValidatorStatistic) error
 		return err
 	}
 
-	return slash(ctx, statistic, state.Params.ByzantineSlashingPercentage.Value)
+	byzantineSlashPercentage := doubleSignSlashPercentage
+	if state.Params.ByzantineSlashingPercentage != nil {
+		byzantineSlashPercentage = state.Params.ByzantineSlashingPercentage.Value
+	}
+
+	return slash(ctx, statistic, byzantineSlashPercentage)
+}
+
+// SlashByzantineValidator slashes & jails a validator that double-signed a block at the given height.
+// The slash is applied to the validator's delegations & whitelist amount at the end of the next
+// election. Each piece of evidence is only processed once, and evidence for validators that no
+// longer have any statistics on record is ignored.
+func SlashByzantineValidator(ctx contract.Context, validatorAddr loom.Address, evidenceHeight int64) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_13, false) {
+		return nil
+	}
+
+	if hasByzantineEvidence(ctx, validatorAddr, evidenceHeight) {
+		return nil
+	}
+
+	statistic, err := GetStatistic(ctx, validatorAddr)
+	if err == contract.ErrNotFound {
+		ctx.Logger().Error("DPOSv3 SlashByzantineValidator", "validator", validatorAddr, "err", err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	ctx.Logger().Info(
+		"DPOSv3 SlashByzantineValidator", "validator", validatorAddr, "evidenceHeight", evidenceHeight,
+	)
+
+	if err := SlashDoubleSign(ctx, statistic); err != nil {
+		return err
+	}
+
+	if !statistic.Jailed {
+		statistic.Jailed = true
+		if err := emitJailEvent(ctx, validatorAddr.MarshalPB()); err != nil {
+			return err
+		}
+	}
+
+	if err := SetStatistic(ctx, statistic); err != nil {
+		return err
+	}
+
+	return setByzantineEvidence(ctx, validatorAddr, evidenceHeight)
 }
 
 func slash(ctx contract.Context, statistic *ValidatorStatistic, slashPercentage loom.BigUInt) error {
@@ -1856,6 +2040,17 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 			// If a validator is jailed, don't calculate and distribute rewards
 			if ctx.FeatureEnabled(features.DPOSVersion3_3, false) {
 				if statistic.Jailed {
+					// A jailed validator doesn't earn any rewards, but any slashes it incurred
+					// must still be applied to its delegations.
+					if ctx.FeatureEnabled(features.DPOSVersion3_13, false) &&
+						!common.IsZero(statistic.SlashPercentage.Value) {
+						if err := slashValidatorDelegations(ctx, cachedDelegations, statistic, candidateAddress); err != nil {
+							return nil, err
+						}
+						if err := SetStatistic(ctx, statistic); err != nil {
+							return nil, err
+						}
+					}
 					delegatorRewards[validatorKey] = common.BigZero()
 					formerValidatorTotals[validatorKey] = *common.BigZero()
 					continue
@@ -1903,6 +2098,9 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 						// referrer fees are delegater to limbo validator
 						distributedRewards.Add(distributedRewards, &referrerReward)
 						cachedDelegations.IncreaseRewardDelegation(ctx, LimboValidatorAddress(ctx).MarshalPB(), referrerAddress, referrerReward)
+						if err := recordDelegatorReward(ctx, LimboValidatorAddress(ctx).MarshalPB(), referrerAddress, referrerReward); err != nil {
+							return nil, err
+						}
 
 						// any referrer bonus amount is subtracted from the validatorShare
 						validatorShare.Sub(&validatorShare, &referrerReward)
@@ -1911,6 +2109,9 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 
 				distributedRewards.Add(distributedRewards, &validatorShare)
 				cachedDelegations.IncreaseRewardDelegation(ctx, candidate.Address, candidate.Address, validatorShare)
+				if err := recordDelegatorReward(ctx, candidate.Address, candidate.Address, validatorShare); err != nil {
+					return nil, err
+				}
 
 				// If a validator has some non-zero WhitelistAmount,
 				// calculate the validator's reward based on whitelist amount
@@ -1920,6 +2121,13 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 					// increase a delegator's distribution
 					distributedRewards.Add(distributedRewards, &whitelistDistribution)
 					cachedDelegations.IncreaseRewardDelegation(ctx, candidate.Address, candidate.Address, whitelistDistribution)
+					if err := recordDelegatorReward(ctx, candidate.Address, candidate.Address, whitelistDistribution); err != nil {
+						return nil, err
+					}
+				}
+
+				if err := recordValidatorReward(ctx, candidate.Address, distributionTotal, validatorShare); err != nil {
+					return nil, err
 				}
 
 				// Keeping track of cumulative distributed rewards by adding
@@ -2024,6 +2232,15 @@ func slashValidatorDelegations(
 			updatedAmount := common.BigZero()
 			updatedAmount.Sub(&delegation.Amount.Value, &toSlash)
 			delegation.Amount = &types.BigUInt{Value: *updatedAmount}
+			// The stake unbonding from a liquid stake pool is owed to redemptions, so it must bear
+			// its share of the slash, otherwise the whole slash would land on the remaining shares.
+			if delegation.State == UNBONDING && ctx.FeatureEnabled(features.DPOSVersion3_11, false) &&
+				isLiquidStakeDelegation(ctx, delegation) {
+				unbondingSlash := CalculateFraction(statistic.SlashPercentage.Value, delegation.UpdateAmount.Value)
+				updatedUnbonding := common.BigZero()
+				updatedUnbonding.Sub(&delegation.UpdateAmount.Value, &unbondingSlash)
+				delegation.UpdateAmount = &types.BigUInt{Value: *updatedUnbonding}
+			}
 			if err := cachedDelegations.SetDelegation(ctx, delegation); err != nil {
 				return err
 			}
@@ -2115,20 +2332,37 @@ func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedD
 				delegatorDistribution := calculateShare(weightedDelegation, delegationTotal, *rewardsTotal)
 				// increase a delegator's distribution
 				distributedRewards.Add(distributedRewards, &delegatorDistribution)
-				cachedDelegations.IncreaseRewardDelegation(ctx, delegation.Validator, delegation.Delegator, delegatorDistribution)
-
-				// If the reward delegation is updated by the
-				// IncreaseRewardDelegation command, we must be sure to use this
-				// updated version in the rest of the loop. No other delegations
-				// (non-rewards) have the possibility of being updated outside
-				// of this loop.
-				if ctx.FeatureEnabled(features.DPOSVersion3_1, false) && d.Index == REWARD_DELEGATION_INDEX {
-					delegation, err = GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
-					if err == contract.ErrNotFound {
-						continue
-					} else if err != nil {
+				if err := recordDelegatorReward(ctx, delegation.Validator, delegation.Delegator, delegatorDistribution); err != nil {
+					return nil, err
+				}
+
+				if shouldAutoCompound(ctx, delegation) {
+					// re-delegate the rewards to the same validator & tier by adding them to the
+					// delegation that earned them
+					updatedAmount := common.BigZero()
+					updatedAmount.Add(&delegation.Amount.Value, &delegatorDistribution)
+					delegation.Amount = &types.BigUInt{Value: *updatedAmount}
+					if err := emitDelegatorCompoundsEvent(
+						ctx, delegation, &types.BigUInt{Value: delegatorDistribution},
+					); err != nil {
 						return nil, err
 					}
+				} else {
+					cachedDelegations.IncreaseRewardDelegation(ctx, delegation.Validator, delegation.Delegator, delegatorDistribution)
+
+					// If the reward delegation is updated by the
+					// IncreaseRewardDelegation command, we must be sure to use this
+					// updated version in the rest of the loop. No other delegations
+					// (non-rewards) have the possibility of being updated outside
+					// of this loop.
+					if ctx.FeatureEnabled(features.DPOSVersion3_1, false) && d.Index == REWARD_DELEGATION_INDEX {
+						delegation, err = GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+						if err == contract.ErrNotFound {
+							continue
+						} else if err != nil {
+							return nil, err
+						}
+					}
 				}
 			}
 		}
@@ -2137,22 +2371,42 @@ func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedD
 		if delegation.State == BONDING {
 			updatedAmount.Add(&delegation.Amount.Value, &delegation.UpdateAmount.Value)
 			delegation.Amount = &types.BigUInt{Value: *updatedAmount}
+			if err := recordDelegationChange(
+				ctx, delegation, DelegationChange_BONDED, delegation.UpdateAmount.Value, nil,
+			); err != nil {
+				return nil, err
+			}
 		} else if delegation.State == UNBONDING {
 			updatedAmount.Sub(&delegation.Amount.Value, &delegation.UpdateAmount.Value)
 			delegation.Amount = &types.BigUInt{Value: *updatedAmount}
-			coin, err := loadCoin(ctx)
-			if err != nil {
+			if err := recordDelegationChange(
+				ctx, delegation, DelegationChange_UNBONDED, delegation.UpdateAmount.Value, nil,
+			); err != nil {
 				return nil, err
 			}
-			err = coin.Transfer(loom.UnmarshalAddressPB(delegation.Delegator), &delegation.UpdateAmount.Value)
-			if err != nil {
-				transferFromErr := fmt.Sprintf("Failed coin Transfer - distributeDelegatorRewards, %v, %s", delegation.Delegator.String(), delegation.UpdateAmount.Value.String())
-				return nil, logDposError(ctx, err, transferFromErr)
+			if ctx.FeatureEnabled(features.DPOSVersion3_11, false) && isLiquidStakeDelegation(ctx, delegation) {
+				// Tokens unbonded from a liquid stake pool remain in the contract until the
+				// redemptions they're owed to are paid out.
+				if err := addLiquidStakeUnbonded(ctx, delegation.Validator, delegation.UpdateAmount.Value); err != nil {
+					return nil, err
+				}
+			} else {
+				coin, err := loadCoin(ctx)
+				if err != nil {
+					return nil, err
+				}
+				err = coin.Transfer(loom.UnmarshalAddressPB(delegation.Delegator), &delegation.UpdateAmount.Value)
+				if err != nil {
+					transferFromErr := fmt.Sprintf("Failed coin Transfer - distributeDelegatorRewards, %v, %s", delegation.Delegator.String(), delegation.UpdateAmount.Value.String())
+					return nil, logDposError(ctx, err, transferFromErr)
+				}
 			}
 		} else if delegation.State == REDELEGATING {
 			if err = cachedDelegations.DeleteDelegation(ctx, delegation); err != nil {
 				return nil, err
 			}
+			previousValidator := delegation.Validator
+			previousIndex := delegation.Index
 			delegation.Validator = delegation.UpdateValidator
 			delegation.Amount = delegation.UpdateAmount
 			delegation.LocktimeTier = delegation.UpdateLocktimeTier
@@ -2163,6 +2417,20 @@ func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedD
 			}
 			delegation.Index = index
 
+			if ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+				if err := reindexRedelegationRecords(
+					ctx, previousValidator, delegation.Validator, delegation.Delegator, previousIndex, index,
+				); err != nil {
+					return nil, err
+				}
+			}
+
+			if err := recordDelegationChange(
+				ctx, delegation, DelegationChange_REDELEGATED, delegation.Amount.Value, previousValidator,
+			); err != nil {
+				return nil, err
+			}
+
 			validatorKey = loom.UnmarshalAddressPB(delegation.Validator).String()
 		}
 
@@ -2173,6 +2441,9 @@ func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedD
 			if err := cachedDelegations.DeleteDelegation(ctx, delegation); err != nil {
 				return nil, err
 			}
+			if ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+				deleteRedelegationCooldown(ctx, delegation)
+			}
 		} else {
 			// After a delegation update, zero out UpdateAmount
 			delegation.UpdateAmount = loom.BigZeroPB()
@@ -2200,6 +2471,21 @@ func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedD
 	return newDelegationTotals, nil
 }
 
+// Returns true if the rewards earned by the given delegation should be added to the delegation
+// itself rather than the rewards delegation. Rewards are only compounded into bonded delegations,
+// the rewards delegation compounds by default.
+func shouldAutoCompound(ctx contract.Context, delegation *Delegation) bool {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_12, false) {
+		return false
+	}
+	if delegation.Index == REWARD_DELEGATION_INDEX || delegation.State != BONDED {
+		return false
+	}
+	return isAutoCompoundEnabled(
+		ctx, loom.UnmarshalAddressPB(delegation.Validator), loom.UnmarshalAddressPB(delegation.Delegator),
+	)
+}
+
 // Reset a delegation's tier to 0 if it's locktime has expired
 func resetDelegationIfExpired(ctx contract.Context, delegation *Delegation) {
 	now := uint64(ctx.Now().Unix())
@@ -2780,6 +3066,21 @@ func (c *DPOS) emitDelegatorUnbondsEvent(ctx contract.Context, delegation *Deleg
 	return nil
 }
 
+func emitDelegatorCompoundsEvent(ctx contract.Context, delegation *Delegation, amount *types.BigUInt) error {
+	marshalled, err := proto.Marshal(&DposDelegatorCompoundsEvent{
+		Validator: delegation.Validator,
+		Delegator: delegation.Delegator,
+		Index:     delegation.Index,
+		Amount:    amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, DelegatorCompoundsEventTopic)
+	return nil
+}
+
 func (c *DPOS) emitReferrerRegistersEvent(ctx contract.Context, name string, address *types.Address) error {
 	marshalled, err := proto.Marshal(&DposReferrerRegistersEvent{
 		Name:    name,
@@ -2851,11 +3152,9 @@ func getValidatorStatistics(ctx contract.StaticContext) ([]*ValidatorStatistic,
 		return nil, logStaticDposError(ctx, err, "")
 	}
 
-	chainID := ctx.Block().ChainID
-
 	displayStatistics := make([]*ValidatorStatistic, 0)
 	for _, validator := range validators {
-		address := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(validator.PubKey)}
+		address := candidateAddressFromPubKey(ctx, validator.PubKey)
 
 		// get validator statistics
 		stat, _ := GetStatistic(ctx, address)
@@ -2,6 +2,7 @@ package dposv3
 
 import (
 	"encoding/hex"
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -357,6 +358,137 @@ func TestChangeFee(t *testing.T) {
 	assert.Equal(t, newFee, candidates[0].Candidate.NewFee)
 }
 
+func TestCandidateFeeLimits(t *testing.T) {
+	oldFee := uint64(100)
+	oraclePubKey, _ := hex.DecodeString(validatorPubKeyHex2)
+	oracleAddr := loom.Address{
+		Local: loom.LocalAddressFromPublicKey(oraclePubKey),
+	}
+
+	pubKey, _ := hex.DecodeString(validatorPubKeyHex1)
+	addr := loom.Address{
+		ChainID: chainID,
+		Local:   loom.LocalAddressFromPublicKey(pubKey),
+	}
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_14, true)
+
+	// Deploy the coin contract (DPOS Init() will attempt to resolve it)
+	coinContract := &coin.Coin{}
+	_ = pctx.CreateContract(contractpb.MakePluginContract(coinContract))
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount: 21,
+		OracleAddress:  oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	amount := big.NewInt(1000000000000)
+	err = dpos.WhitelistCandidate(pctx.WithSender(oracleAddr), addr, amount, 0)
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(addr), pubKey, nil, &oldFee, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	setLimits := func(maxFee, maxFeeChange uint64) error {
+		return dpos.Contract.SetCandidateFeeLimits(
+			contractpb.WrapPluginContext(dposCtx.WithSender(addr)),
+			&SetCandidateFeeLimitsRequest{MaxFee: maxFee, MaxFeeChange: maxFeeChange},
+		)
+	}
+	require.NoError(t, setLimits(2000, 500))
+	// limits can only be tightened
+	require.Error(t, setLimits(3000, 500))
+	require.Error(t, setLimits(2000, 600))
+	// the max fee can't be lower than the current fee
+	require.Error(t, setLimits(50, 500))
+
+	limitsResp, err := dpos.Contract.GetCandidateFeeLimits(
+		contractpb.WrapPluginContext(dposCtx), &GetCandidateFeeLimitsRequest{Candidate: addr.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), limitsResp.Limits.MaxFee)
+	require.Equal(t, uint64(500), limitsResp.Limits.MaxFeeChange)
+
+	// the fee can't exceed the max fee
+	require.Error(t, dpos.ChangeFee(pctx.WithSender(addr), 3000))
+
+	// fee increases are applied in steps no larger than the max fee change
+	require.NoError(t, dpos.ChangeFee(pctx.WithSender(addr), 1500))
+	pendingResp, err := dpos.Contract.ListPendingFeeChanges(
+		contractpb.WrapPluginContext(dposCtx), &ListPendingFeeChangesRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pendingResp.Changes))
+	require.Equal(t, uint64(1500), pendingResp.Changes[0].TargetFee)
+	require.Equal(t, uint64(600), pendingResp.Changes[0].NextFee)
+
+	// the first step is applied after two elections, and every following step after each election
+	expectedFees := []uint64{100, 600, 1100, 1500}
+	for _, expectedFee := range expectedFees {
+		require.NoError(t, elect(pctx, dpos.Address))
+		candidates, err := dpos.ListCandidates(pctx)
+		require.Nil(t, err)
+		require.Equal(t, expectedFee, candidates[0].Candidate.Fee)
+	}
+
+	candidates, err := dpos.ListCandidates(pctx)
+	require.Nil(t, err)
+	require.Equal(t, REGISTERED, candidates[0].Candidate.State)
+	pendingResp, err = dpos.Contract.ListPendingFeeChanges(
+		contractpb.WrapPluginContext(dposCtx), &ListPendingFeeChangesRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pendingResp.Changes))
+
+	// fee decreases aren't rate-limited
+	require.NoError(t, dpos.ChangeFee(pctx.WithSender(addr), 200))
+	require.NoError(t, elect(pctx, dpos.Address))
+	require.NoError(t, elect(pctx, dpos.Address))
+	candidates, err = dpos.ListCandidates(pctx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(200), candidates[0].Candidate.Fee)
+
+	// candidates without limits are still subject to the protocol-wide max fee change
+	err = dpos.WhitelistCandidate(pctx.WithSender(oracleAddr), addr3, amount, 0)
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(addr3), pubKey3, nil, &oldFee, nil, nil, nil, nil)
+	require.Nil(t, err)
+	require.NoError(t, dpos.ChangeFee(pctx.WithSender(addr3), 1500))
+	for _, expectedFee := range []uint64{100, 600, 1100, 1500} {
+		require.NoError(t, elect(pctx, dpos.Address))
+		candidates, err := dpos.ListCandidates(pctx)
+		require.Nil(t, err)
+		for _, c := range candidates {
+			if loom.UnmarshalAddressPB(c.Candidate.Address).Compare(addr3) == 0 {
+				require.Equal(t, expectedFee, c.Candidate.Fee)
+			}
+		}
+	}
+
+	// the limits still apply when a candidate unregisters and registers again
+	require.NoError(t, dpos.UnregisterCandidate(pctx.WithSender(addr)))
+	require.NoError(t, elect(pctx, dpos.Address))
+	highFee := uint64(2500)
+	require.Error(t, dpos.RegisterCandidate(pctx.WithSender(addr), pubKey, nil, &highFee, nil, nil, nil, nil))
+	newFee := uint64(1500)
+	require.NoError(t, dpos.RegisterCandidate(pctx.WithSender(addr), pubKey, nil, &newFee, nil, nil, nil, nil))
+	candidates, err = dpos.ListCandidates(pctx)
+	require.Nil(t, err)
+	for _, c := range candidates {
+		if loom.UnmarshalAddressPB(c.Candidate.Address).Compare(addr) == 0 {
+			require.Equal(t, uint64(200), c.Candidate.Fee)
+			require.Equal(t, uint64(700), c.Candidate.NewFee)
+		}
+	}
+	pendingResp, err = dpos.Contract.ListPendingFeeChanges(
+		contractpb.WrapPluginContext(dposCtx), &ListPendingFeeChangesRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pendingResp.Changes))
+	require.Equal(t, uint64(1500), pendingResp.Changes[0].TargetFee)
+}
+
 func TestDelegate(t *testing.T) {
 	pctx := createCtx()
 	limboValidatorAddress := LimboValidatorAddress(contractpb.WrapPluginStaticContext(pctx))
@@ -4057,6 +4189,1012 @@ func TestEnableValidatorJailing(t *testing.T) {
 	require.False(t, statistic.Jailed)
 }
 
+func TestLiquidStaking(t *testing.T) {
+	pctx := createCtx()
+
+	oraclePubKey, _ := hex.DecodeString(validatorPubKeyHex2)
+	oracleAddr := loom.Address{
+		Local: loom.LocalAddressFromPublicKey(oraclePubKey),
+	}
+
+	valAddr1 := addr1
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 1000000000000000000),
+			makeAccount(delegatorAddress2, 1000000000000000000),
+			makeAccount(valAddr1, 1000000000000000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:      21,
+		OracleAddress:       oracleAddr.MarshalPB(),
+		ElectionCycleLength: 0,                // elections run every block, unbonding isn't locked
+		MaxYearlyReward:     loom.BigZeroPB(), // don't want rewards paid out for this test
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	whitelistAmount := big.NewInt(1000000000000)
+	err = dpos.WhitelistCandidate(pctx.WithSender(oracleAddr), valAddr1, whitelistAmount, 0)
+	require.NoError(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(valAddr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	delegationAmount := big.NewInt(1e18)
+	err = coinContract.Approve(
+		contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)),
+		&coin.ApproveRequest{
+			Spender: dpos.Address.MarshalPB(),
+			Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+		},
+	)
+	require.NoError(t, err)
+
+	delegateReq := &DelegateLiquidRequest{
+		ValidatorAddress: valAddr1.MarshalPB(),
+		Amount:           &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+	}
+	// liquid staking is only available after v3.11 is enabled
+	_, err = dpos.Contract.DelegateLiquid(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)), delegateReq,
+	)
+	require.Equal(t, errLiquidStakingDisabled, err)
+
+	pctx.SetFeature(features.DPOSVersion3_11, true)
+	delegateResp, err := dpos.Contract.DelegateLiquid(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)), delegateReq,
+	)
+	require.NoError(t, err)
+	// the first liquid delegation mints one share per token
+	require.Equal(t, delegationAmount, delegateResp.Shares.Value.Int)
+
+	// the pool delegation is owned by the DPOS contract
+	_, poolAmount, _, err := dpos.CheckDelegation(pctx, &valAddr1, &dpos.Address)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), poolAmount.Int64())
+	require.NoError(t, elect(pctx, dpos.Address))
+	_, poolAmount, _, err = dpos.CheckDelegation(pctx, &valAddr1, &dpos.Address)
+	require.NoError(t, err)
+	require.Equal(t, delegationAmount, poolAmount)
+
+	// transfer half the shares to another account
+	halfAmount := big.NewInt(5e17)
+	err = dpos.Contract.TransferLiquidStake(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)),
+		&TransferLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			To:               delegatorAddress2.MarshalPB(),
+			Shares:           &types.BigUInt{Value: *loom.NewBigUInt(halfAmount)},
+		},
+	)
+	require.NoError(t, err)
+	err = dpos.Contract.TransferLiquidStake(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress2)),
+		&TransferLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			To:               delegatorAddress1.MarshalPB(),
+			Shares:           &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+		},
+	)
+	require.Error(t, err, "should not be able to transfer more shares than owned")
+
+	checkResp, err := dpos.Contract.CheckLiquidStake(
+		contractpb.WrapPluginContext(dposCtx),
+		&CheckLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			Owner:            delegatorAddress2.MarshalPB(),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, halfAmount, checkResp.Shares.Value.Int)
+	require.Equal(t, halfAmount, checkResp.Value.Value.Int)
+
+	// redeeming the shares unbonds the stake from the pool, it's paid out at the next election
+	balanceResp, err := coinContract.BalanceOf(
+		contractpb.WrapPluginContext(coinCtx),
+		&coin.BalanceOfRequest{Owner: delegatorAddress2.MarshalPB()},
+	)
+	require.NoError(t, err)
+	balanceBefore := balanceResp.Balance.Value
+
+	redeemResp, err := dpos.Contract.RedeemLiquidStake(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress2)),
+		&RedeemLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			Shares:           &types.BigUInt{Value: *loom.NewBigUInt(halfAmount)},
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, common.IsZero(redeemResp.Paid.Value))
+	require.Equal(t, halfAmount, redeemResp.Pending.Value.Int)
+
+	redemptionsResp, err := dpos.Contract.ListLiquidRedemptions(
+		contractpb.WrapPluginContext(dposCtx), &ListLiquidRedemptionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(redemptionsResp.Redemptions))
+
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	balanceResp, err = coinContract.BalanceOf(
+		contractpb.WrapPluginContext(coinCtx),
+		&coin.BalanceOfRequest{Owner: delegatorAddress2.MarshalPB()},
+	)
+	require.NoError(t, err)
+	expectedBalance := common.BigZero()
+	expectedBalance.Add(&balanceBefore, loom.NewBigUInt(halfAmount))
+	require.Equal(t, expectedBalance.Int, balanceResp.Balance.Value.Int)
+
+	redemptionsResp, err = dpos.Contract.ListLiquidRedemptions(
+		contractpb.WrapPluginContext(dposCtx), &ListLiquidRedemptionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(redemptionsResp.Redemptions))
+
+	// the remaining shares are still backed by the pool
+	_, poolAmount, _, err = dpos.CheckDelegation(pctx, &valAddr1, &dpos.Address)
+	require.NoError(t, err)
+	require.Equal(t, halfAmount, poolAmount)
+	checkResp, err = dpos.Contract.CheckLiquidStake(
+		contractpb.WrapPluginContext(dposCtx),
+		&CheckLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			Owner:            delegatorAddress1.MarshalPB(),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, halfAmount, checkResp.Value.Value.Int)
+
+	// the liquid stake can be transferred by an approved spender
+	tenthAmount := big.NewInt(1e17)
+	err = dpos.Contract.ApproveLiquidStake(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)),
+		&ApproveLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			Spender:          delegatorAddress2.MarshalPB(),
+			Shares:           &types.BigUInt{Value: *loom.NewBigUInt(tenthAmount)},
+		},
+	)
+	require.NoError(t, err)
+	transferFromReq := &TransferLiquidStakeFromRequest{
+		ValidatorAddress: valAddr1.MarshalPB(),
+		From:             delegatorAddress1.MarshalPB(),
+		To:               delegatorAddress2.MarshalPB(),
+		Shares:           &types.BigUInt{Value: *loom.NewBigUInt(tenthAmount)},
+	}
+	err = dpos.Contract.TransferLiquidStakeFrom(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress3)), transferFromReq,
+	)
+	require.Error(t, err, "only the approved spender should be able to transfer the shares")
+	err = dpos.Contract.TransferLiquidStakeFrom(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress2)), transferFromReq,
+	)
+	require.NoError(t, err)
+	err = dpos.Contract.TransferLiquidStakeFrom(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress2)), transferFromReq,
+	)
+	require.Error(t, err, "should not be able to transfer more shares than approved")
+	allowanceResp, err := dpos.Contract.LiquidStakeAllowance(
+		contractpb.WrapPluginContext(dposCtx),
+		&LiquidStakeAllowanceRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			Owner:            delegatorAddress1.MarshalPB(),
+			Spender:          delegatorAddress2.MarshalPB(),
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, common.IsZero(allowanceResp.Shares.Value))
+	err = dpos.Contract.TransferLiquidStake(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress2)),
+		&TransferLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			To:               delegatorAddress1.MarshalPB(),
+			Shares:           &types.BigUInt{Value: *loom.NewBigUInt(tenthAmount)},
+		},
+	)
+	require.NoError(t, err)
+
+	// if the pool is slashed while a redemption is unbonding the redeemer bears the loss on the
+	// redeemed stake, the remaining shares only lose the slashed fraction of their own value
+	quarterAmount := big.NewInt(25e16)
+	balanceResp, err = coinContract.BalanceOf(
+		contractpb.WrapPluginContext(coinCtx),
+		&coin.BalanceOfRequest{Owner: delegatorAddress1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	balanceBefore = balanceResp.Balance.Value
+
+	_, err = dpos.Contract.RedeemLiquidStake(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)),
+		&RedeemLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			Shares:           &types.BigUInt{Value: *loom.NewBigUInt(quarterAmount)},
+		},
+	)
+	require.NoError(t, err)
+
+	slashValidator := func(basisPoints int64) {
+		statistic, err := GetStatistic(contractpb.WrapPluginContext(dposCtx), valAddr1)
+		require.NoError(t, err)
+		require.NoError(t, slash(contractpb.WrapPluginContext(dposCtx), statistic, *loom.NewBigUIntFromInt(basisPoints)))
+		require.NoError(t, SetStatistic(contractpb.WrapPluginContext(dposCtx), statistic))
+	}
+	slashValidator(1000) // 10%
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	redemptionsResp, err = dpos.Contract.ListLiquidRedemptions(
+		contractpb.WrapPluginContext(dposCtx), &ListLiquidRedemptionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(redemptionsResp.Redemptions))
+
+	slashedQuarterAmount := big.NewInt(225e15)
+	balanceResp, err = coinContract.BalanceOf(
+		contractpb.WrapPluginContext(coinCtx),
+		&coin.BalanceOfRequest{Owner: delegatorAddress1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	expectedBalance = common.BigZero()
+	expectedBalance.Add(&balanceBefore, loom.NewBigUInt(slashedQuarterAmount))
+	require.Equal(t, expectedBalance.Int, balanceResp.Balance.Value.Int)
+
+	checkResp, err = dpos.Contract.CheckLiquidStake(
+		contractpb.WrapPluginContext(dposCtx),
+		&CheckLiquidStakeRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			Owner:            delegatorAddress1.MarshalPB(),
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, quarterAmount, checkResp.Shares.Value.Int)
+	require.Equal(t, slashedQuarterAmount, checkResp.Value.Value.Int)
+
+	// no new liquid stake is accepted once the pool has been slashed down to nothing
+	slashValidator(10000) // 100%
+	require.NoError(t, elect(pctx, dpos.Address))
+	_, err = dpos.Contract.DelegateLiquid(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress2)), delegateReq,
+	)
+	require.Equal(t, errLiquidStakePoolDepleted, err)
+}
+
+func TestAutoCompound(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_12, true)
+
+	oraclePubKey, _ := hex.DecodeString(validatorPubKeyHex2)
+	oracleAddr := loom.Address{
+		Local: loom.LocalAddressFromPublicKey(oraclePubKey),
+	}
+
+	valAddr1 := addr1
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 1000000000000000000),
+			makeAccount(valAddr1, 1000000000000000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount: 21,
+		OracleAddress:  oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	// transfer coins to reward fund
+	amount := big.NewInt(10)
+	amount.Exp(amount, big.NewInt(24), nil)
+	err = coinContract.Transfer(
+		contractpb.WrapPluginContext(coinCtx.WithSender(valAddr1)),
+		&coin.TransferRequest{
+			To:     dpos.Address.MarshalPB(),
+			Amount: &types.BigUInt{Value: *loom.NewBigUInt(amount)},
+		},
+	)
+	require.NoError(t, err)
+
+	whitelistAmount := scientificNotation(1000000, tokenDecimals)
+	err = dpos.WhitelistCandidate(pctx.WithSender(oracleAddr), valAddr1, whitelistAmount.Int, 0)
+	require.NoError(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(valAddr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	delegationAmount := scientificNotation(1000000, tokenDecimals)
+	err = coinContract.Approve(
+		contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)),
+		&coin.ApproveRequest{
+			Spender: dpos.Address.MarshalPB(),
+			Amount:  &types.BigUInt{Value: *delegationAmount},
+		},
+	)
+	require.NoError(t, err)
+	tierTwo := uint64(2)
+	err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &valAddr1, delegationAmount.Int, &tierTwo, nil)
+	require.NoError(t, err)
+
+	err = dpos.Contract.SetAutoCompound(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)),
+		&SetAutoCompoundRequest{ValidatorAddress: valAddr1.MarshalPB(), Enabled: true},
+	)
+	require.NoError(t, err)
+	checkResp, err := dpos.Contract.CheckAutoCompound(
+		contractpb.WrapPluginContext(dposCtx),
+		&CheckAutoCompoundRequest{
+			ValidatorAddress: valAddr1.MarshalPB(),
+			DelegatorAddress: delegatorAddress1.MarshalPB(),
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, checkResp.Enabled)
+
+	// the first election bonds the delegation, the second one rewards it
+	require.NoError(t, elect(pctx, dpos.Address))
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	// rewards should've been added to the delegation instead of the rewards delegation
+	delegations, _, _, err := dpos.CheckDelegation(pctx, &valAddr1, &delegatorAddress1)
+	require.NoError(t, err)
+	for _, d := range delegations {
+		if d.Index == REWARD_DELEGATION_INDEX {
+			require.True(t, common.IsZero(d.Amount.Value))
+		} else {
+			require.Equal(t, TIER_TWO, d.LocktimeTier)
+			require.True(t, d.Amount.Value.Cmp(delegationAmount) > 0)
+		}
+	}
+
+	// once auto-compounding is disabled rewards go back to the rewards delegation
+	err = dpos.Contract.SetAutoCompound(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)),
+		&SetAutoCompoundRequest{ValidatorAddress: valAddr1.MarshalPB(), Enabled: false},
+	)
+	require.NoError(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	rewardDelegation, err := dpos.CheckRewardDelegation(pctx.WithSender(delegatorAddress1), &valAddr1)
+	require.NoError(t, err)
+	require.True(t, common.IsPositive(rewardDelegation.Amount.Value))
+}
+
+func TestByzantineSlashing(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_3, true)
+	pctx.SetFeature(features.DPOSVersion3_13, true)
+	oraclePubKey, _ := hex.DecodeString(validatorPubKeyHex2)
+	oracleAddr := loom.Address{
+		Local: loom.LocalAddressFromPublicKey(oraclePubKey),
+	}
+
+	// Deploy the coin contract (DPOS Init() will attempt to resolve it)
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(addr1, 1000000000000000000),
+			makeAccount(delegatorAddress1, 100000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount: 21,
+		OracleAddress:  oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	whitelistAmount := big.NewInt(1000000000000)
+	err = dpos.WhitelistCandidate(dposCtx.WithSender(oracleAddr), addr1, whitelistAmount, 0)
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(dposCtx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	delegationAmount := big.NewInt(100)
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+	})
+	require.Nil(t, err)
+	err = dpos.Delegate(dposCtx.WithSender(delegatorAddress1), &addr1, delegationAmount, nil, nil)
+	require.Nil(t, err)
+	require.NoError(t, elect(dposCtx, dpos.Address))
+
+	// evidence of double-signing should result in the validator being slashed & jailed
+	evidenceHeight := int64(10)
+	err = SlashByzantineValidator(contractpb.WrapPluginContext(dposCtx), addr1, evidenceHeight)
+	require.NoError(t, err)
+	statistic, err := GetStatistic(contractpb.WrapPluginContext(dposCtx), addr1)
+	require.Nil(t, err)
+	require.True(t, statistic.Jailed)
+	require.Equal(t, doubleSignSlashPercentage.Int64(), statistic.SlashPercentage.Value.Int64())
+
+	// the same evidence shouldn't be processed twice
+	err = SlashByzantineValidator(contractpb.WrapPluginContext(dposCtx), addr1, evidenceHeight)
+	require.NoError(t, err)
+	statistic, err = GetStatistic(contractpb.WrapPluginContext(dposCtx), addr1)
+	require.Nil(t, err)
+	require.Equal(t, doubleSignSlashPercentage.Int64(), statistic.SlashPercentage.Value.Int64())
+
+	// the slash should be applied to the jailed validator's delegations & whitelist amount
+	// at the end of the next election
+	require.NoError(t, elect(dposCtx, dpos.Address))
+
+	delegations, _, _, err := dpos.CheckDelegation(pctx, &addr1, &delegatorAddress1)
+	require.NoError(t, err)
+	for _, d := range delegations {
+		if d.Index != REWARD_DELEGATION_INDEX {
+			require.Equal(t, int64(95), d.Amount.Value.Int64())
+		}
+	}
+
+	statistic, err = GetStatistic(contractpb.WrapPluginContext(dposCtx), addr1)
+	require.Nil(t, err)
+	require.True(t, statistic.Jailed)
+	require.True(t, common.IsZero(statistic.SlashPercentage.Value))
+	require.Equal(t, int64(950000000000), statistic.WhitelistAmount.Value.Int64())
+}
+
+func TestRewardAndDelegationHistory(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_15, true)
+
+	oraclePubKey, _ := hex.DecodeString(validatorPubKeyHex2)
+	oracleAddr := loom.Address{
+		Local: loom.LocalAddressFromPublicKey(oraclePubKey),
+	}
+
+	valAddr1 := addr1
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 1000000000000000000),
+			makeAccount(valAddr1, 1000000000000000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount: 21,
+		OracleAddress:  oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	// transfer coins to reward fund
+	amount := big.NewInt(10)
+	amount.Exp(amount, big.NewInt(24), nil)
+	err = coinContract.Transfer(
+		contractpb.WrapPluginContext(coinCtx.WithSender(valAddr1)),
+		&coin.TransferRequest{
+			To:     dpos.Address.MarshalPB(),
+			Amount: &types.BigUInt{Value: *loom.NewBigUInt(amount)},
+		},
+	)
+	require.NoError(t, err)
+
+	whitelistAmount := scientificNotation(1000000, tokenDecimals)
+	err = dpos.WhitelistCandidate(pctx.WithSender(oracleAddr), valAddr1, whitelistAmount.Int, 0)
+	require.NoError(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(valAddr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	delegationAmount := scientificNotation(1000000, tokenDecimals)
+	err = coinContract.Approve(
+		contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)),
+		&coin.ApproveRequest{
+			Spender: dpos.Address.MarshalPB(),
+			Amount:  &types.BigUInt{Value: *delegationAmount},
+		},
+	)
+	require.NoError(t, err)
+	err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &valAddr1, delegationAmount.Int, nil, nil)
+	require.NoError(t, err)
+
+	// the first election bonds the delegation, the second one rewards it
+	pctx.SetTime(pctx.Now().Add(time.Second))
+	require.NoError(t, elect(pctx, dpos.Address))
+	pctx.SetTime(pctx.Now().Add(time.Second))
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	delegationHistory, err := dpos.Contract.GetDelegationHistory(
+		contractpb.WrapPluginContext(dposCtx),
+		&GetDelegationHistoryRequest{Delegator: delegatorAddress1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(delegationHistory.Entries))
+	require.Nil(t, delegationHistory.NextCursor)
+	require.Equal(t, DelegationChange_BONDED, delegationHistory.Entries[0].Change)
+	require.Equal(t, 0, delegationHistory.Entries[0].Amount.Value.Cmp(delegationAmount))
+
+	rewardHistory, err := dpos.Contract.GetRewardHistory(
+		contractpb.WrapPluginContext(dposCtx),
+		&GetRewardHistoryRequest{Delegator: delegatorAddress1.MarshalPB(), Validator: valAddr1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rewardHistory.Entries))
+	require.True(t, common.IsPositive(rewardHistory.Entries[0].Amount.Value))
+
+	// the validator was rewarded in both elections that followed its registration
+	rewardHistory, err = dpos.Contract.GetRewardHistory(
+		contractpb.WrapPluginContext(dposCtx),
+		&GetRewardHistoryRequest{Validator: valAddr1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rewardHistory.Entries))
+	require.True(t, rewardHistory.Entries[0].ElectionTime < rewardHistory.Entries[1].ElectionTime)
+	allEntries := rewardHistory.Entries
+
+	// the history can be paged through with the cursor returned with each page
+	rewardHistory, err = dpos.Contract.GetRewardHistory(
+		contractpb.WrapPluginContext(dposCtx),
+		&GetRewardHistoryRequest{Validator: valAddr1.MarshalPB(), Limit: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rewardHistory.Entries))
+	require.Equal(t, allEntries[0].ElectionTime, rewardHistory.Entries[0].ElectionTime)
+	require.NotNil(t, rewardHistory.NextCursor)
+
+	rewardHistory, err = dpos.Contract.GetRewardHistory(
+		contractpb.WrapPluginContext(dposCtx),
+		&GetRewardHistoryRequest{Validator: valAddr1.MarshalPB(), Cursor: rewardHistory.NextCursor, Limit: 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rewardHistory.Entries))
+	require.Equal(t, allEntries[1].ElectionTime, rewardHistory.Entries[0].ElectionTime)
+
+	_, err = dpos.Contract.GetRewardHistory(contractpb.WrapPluginContext(dposCtx), &GetRewardHistoryRequest{})
+	require.Error(t, err)
+
+	// history older than the retention period is pruned
+	pctx.SetTime(pctx.Now().Add(time.Duration(HistoryRetentionPeriod+1) * time.Second))
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	rewardHistory, err = dpos.Contract.GetRewardHistory(
+		contractpb.WrapPluginContext(dposCtx),
+		&GetRewardHistoryRequest{Validator: valAddr1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	// only the entry recorded by the last election remains
+	require.Equal(t, 1, len(rewardHistory.Entries))
+	require.Equal(t, pctx.Now().Unix(), rewardHistory.Entries[0].ElectionTime)
+
+	delegationHistory, err = dpos.Contract.GetDelegationHistory(
+		contractpb.WrapPluginContext(dposCtx),
+		&GetDelegationHistoryRequest{Delegator: delegatorAddress1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(delegationHistory.Entries))
+}
+
+func TestRedelegationLimits(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_16, true)
+
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 1000000000000000000),
+			makeAccount(delegatorAddress2, 1000000000000000000),
+		},
+	})
+
+	oracleAddr := addr4
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:          21,
+		RegistrationRequirement: loom.BigZeroPB(),
+		OracleAddress:           oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	require.NoError(t, dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil))
+	require.NoError(t, dpos.RegisterCandidate(pctx.WithSender(addr2), pubKey2, nil, nil, nil, nil, nil, nil))
+
+	delegationAmount := big.NewInt(10000000)
+	for _, delegator := range []loom.Address{delegatorAddress1, delegatorAddress2} {
+		err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegator)), &coin.ApproveRequest{
+			Spender: dpos.Address.MarshalPB(),
+			Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+		})
+		require.NoError(t, err)
+		require.NoError(t, dpos.Delegate(pctx.WithSender(delegator), &addr1, delegationAmount, nil, nil))
+	}
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	// only the oracle can set the limits
+	limits := &RedelegationLimits{Cooldown: 3600, MaxRedelegationPercentage: 5000}
+	err = dpos.Contract.SetRedelegationLimits(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress1)),
+		&SetRedelegationLimitsRequest{Limits: limits},
+	)
+	require.Error(t, err)
+	err = dpos.Contract.SetRedelegationLimits(
+		contractpb.WrapPluginContext(dposCtx.WithSender(oracleAddr)),
+		&SetRedelegationLimitsRequest{Limits: limits},
+	)
+	require.NoError(t, err)
+
+	// redelegations are queued until the next election
+	require.NoError(t, dpos.Redelegate(pctx.WithSender(delegatorAddress1), &addr1, &addr2, delegationAmount, 1, nil, nil))
+	require.NoError(t, dpos.Redelegate(pctx.WithSender(delegatorAddress2), &addr1, &addr2, delegationAmount, 1, nil, nil))
+
+	// the delegation can't be redelegated again until the cooldown elapses
+	err = dpos.Redelegate(pctx.WithSender(delegatorAddress1), &addr1, &addr2, delegationAmount, 1, nil, nil)
+	require.Error(t, err)
+
+	queue, err := dpos.Contract.ListRedelegationQueue(
+		contractpb.WrapPluginContext(dposCtx), &ListRedelegationQueueRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(queue.Redelegations))
+	require.Equal(t, limits.MaxRedelegationPercentage, queue.Limits.MaxRedelegationPercentage)
+
+	// half of the stake delegated to addr1 can be moved per election, so the second redelegation
+	// has to wait for the following election
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	queue, err = dpos.Contract.ListRedelegationQueue(
+		contractpb.WrapPluginContext(dposCtx), &ListRedelegationQueueRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(queue.Redelegations))
+	require.Equal(t, 0, loom.UnmarshalAddressPB(queue.Redelegations[0].Delegator).Compare(delegatorAddress2))
+
+	_, amount, _, err := dpos.CheckDelegation(pctx, &addr2, &delegatorAddress1)
+	require.NoError(t, err)
+	require.Equal(t, delegationAmount.Int64(), amount.Int64())
+	_, amount, _, err = dpos.CheckDelegation(pctx, &addr2, &delegatorAddress2)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), amount.Int64())
+
+	// only half of the remaining stake delegated to addr1 can be moved in the next election, so the
+	// second redelegation is split, and the rest of it stays queued
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	halfAmount := new(big.Int).Div(delegationAmount, big.NewInt(2))
+	queue, err = dpos.Contract.ListRedelegationQueue(
+		contractpb.WrapPluginContext(dposCtx), &ListRedelegationQueueRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(queue.Redelegations))
+	require.Equal(t, halfAmount.Int64(), queue.Redelegations[0].Amount.Value.Int64())
+
+	_, amount, _, err = dpos.CheckDelegation(pctx, &addr2, &delegatorAddress2)
+	require.NoError(t, err)
+	require.Equal(t, halfAmount.Int64(), amount.Int64())
+	delegations, amount, _, err := dpos.CheckDelegation(pctx, &addr1, &delegatorAddress2)
+	require.NoError(t, err)
+	require.Equal(t, halfAmount.Int64(), amount.Int64())
+	// the queued remainder follows the delegation it belongs to
+	for _, d := range delegations {
+		if d.Index != REWARD_DELEGATION_INDEX {
+			require.Equal(t, d.Index, queue.Redelegations[0].Index)
+		}
+	}
+
+	// redelegated stake inherits the cooldown of the delegation it was moved from
+	delegations, _, _, err = dpos.CheckDelegation(pctx, &addr2, &delegatorAddress1)
+	require.NoError(t, err)
+	index := uint64(0)
+	for _, d := range delegations {
+		if d.Index != REWARD_DELEGATION_INDEX {
+			index = d.Index
+		}
+	}
+	err = dpos.Redelegate(pctx.WithSender(delegatorAddress1), &addr2, &addr1, delegationAmount, index, nil, nil)
+	require.Error(t, err)
+
+	// once the cooldown elapses the delegation can be redelegated again
+	pctx.SetTime(pctx.Now().Add(3600 * time.Second))
+	require.NoError(t, dpos.Redelegate(pctx.WithSender(delegatorAddress1), &addr2, &addr1, delegationAmount, index, nil, nil))
+
+	// the cooldown is deleted along with the delegation, so a new delegation that reuses its index
+	// doesn't inherit it
+	delegations, _, _, err = dpos.CheckDelegation(pctx, &addr2, &delegatorAddress2)
+	require.NoError(t, err)
+	var splitDelegation *Delegation
+	for _, d := range delegations {
+		if d.Index != REWARD_DELEGATION_INDEX {
+			splitDelegation = d
+		}
+	}
+	require.NotNil(t, splitDelegation)
+	staticCtx := contractpb.WrapPluginStaticContext(dposCtx)
+	cooldown, err := loadRedelegationCooldown(staticCtx, splitDelegation)
+	require.NoError(t, err)
+	require.NotNil(t, cooldown)
+
+	pctx.SetTime(pctx.Now().Add(time.Duration(TierLocktimeMap[TIER_THREE]) * time.Second))
+	require.NoError(t, dpos.Unbond(pctx.WithSender(delegatorAddress2), &addr2, halfAmount, splitDelegation.Index))
+	require.NoError(t, elect(pctx, dpos.Address))
+	cooldown, err = loadRedelegationCooldown(staticCtx, splitDelegation)
+	require.NoError(t, err)
+	require.Nil(t, cooldown)
+}
+
+func TestGovernanceProposals(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_17, true)
+
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 1000000000000000000),
+			makeAccount(delegatorAddress2, 1000000000000000000),
+			makeAccount(delegatorAddress3, 1000000000000000000),
+			makeAccount(delegatorAddress4, 1000000000000000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:          21,
+		RegistrationRequirement: loom.BigZeroPB(),
+		OracleAddress:           addr4.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	require.NoError(t, dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil))
+
+	delegationAmount := big.NewInt(10000000)
+	largeDelegationAmount := new(big.Int).Mul(delegationAmount, big.NewInt(2))
+	for i, delegator := range []loom.Address{delegatorAddress1, delegatorAddress2} {
+		amount := delegationAmount
+		if i == 0 {
+			amount = largeDelegationAmount
+		}
+		err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegator)), &coin.ApproveRequest{
+			Spender: dpos.Address.MarshalPB(),
+			Amount:  &types.BigUInt{Value: *loom.NewBigUInt(amount)},
+		})
+		require.NoError(t, err)
+		require.NoError(t, dpos.Delegate(pctx.WithSender(delegator), &addr1, amount, nil, nil))
+	}
+
+	// delegates less than 1% of the total stake
+	smallDelegationAmount := big.NewInt(100)
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress4)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(smallDelegationAmount)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, dpos.Delegate(pctx.WithSender(delegatorAddress4), &addr1, smallDelegationAmount, nil, nil))
+
+	// the liquid staking pool stake can't vote, so it doesn't count towards the quorum
+	pctx.SetFeature(features.DPOSVersion3_11, true)
+	liquidAmount := new(big.Int).Mul(delegationAmount, big.NewInt(10))
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress3)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(liquidAmount)},
+	})
+	require.NoError(t, err)
+	_, err = dpos.Contract.DelegateLiquid(
+		contractpb.WrapPluginContext(dposCtx.WithSender(delegatorAddress3)),
+		&DelegateLiquidRequest{
+			ValidatorAddress: addr1.MarshalPB(),
+			Amount:           &types.BigUInt{Value: *loom.NewBigUInt(liquidAmount)},
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	submit := func(sender loom.Address, param ProposalParam, value int64) (uint64, error) {
+		resp, err := dpos.Contract.SubmitProposal(
+			contractpb.WrapPluginContext(dposCtx.WithSender(sender)),
+			&SubmitProposalRequest{Param: param, Value: &types.BigUInt{Value: *loom.NewBigUIntFromInt(value)}},
+		)
+		if err != nil {
+			return 0, err
+		}
+		return resp.ProposalId, nil
+	}
+	vote := func(sender loom.Address, proposalID uint64, approve bool) error {
+		return dpos.Contract.VoteOnProposal(
+			contractpb.WrapPluginContext(dposCtx.WithSender(sender)),
+			&VoteOnProposalRequest{ProposalId: proposalID, Approve: approve},
+		)
+	}
+
+	// only delegators can submit proposals
+	_, err = submit(addr2, ProposalParam_VALIDATOR_COUNT, 5)
+	require.Error(t, err)
+	_, err = submit(delegatorAddress4, ProposalParam_VALIDATOR_COUNT, 5)
+	require.Error(t, err)
+	// proposed values must be within the bounds of each param
+	_, err = submit(delegatorAddress1, ProposalParam_VALIDATOR_COUNT, 0)
+	require.Error(t, err)
+	_, err = submit(delegatorAddress1, ProposalParam_VALIDATOR_COUNT, 1)
+	require.Error(t, err)
+	_, err = submit(delegatorAddress1, ProposalParam_ELECTION_CYCLE_LENGTH, math.MaxInt64)
+	require.Error(t, err)
+	_, err = submit(delegatorAddress1, ProposalParam_DOWNTIME_PERIOD, 1)
+	require.Error(t, err)
+	_, err = submit(delegatorAddress1, ProposalParam_MAX_YEARLY_REWARD, 0)
+	require.Error(t, err)
+	_, err = submit(delegatorAddress1, ProposalParam_MAX_FEE_CHANGE_PER_ELECTION, 10000)
+	require.Error(t, err)
+
+	validatorCountProposal, err := submit(delegatorAddress1, ProposalParam_VALIDATOR_COUNT, 5)
+	require.NoError(t, err)
+	cycleProposal, err := submit(delegatorAddress2, ProposalParam_ELECTION_CYCLE_LENGTH, 3600)
+	require.NoError(t, err)
+	// each proposer can only have one proposal open for voting
+	_, err = submit(delegatorAddress1, ProposalParam_DOWNTIME_PERIOD, 1024)
+	require.Error(t, err)
+
+	require.NoError(t, vote(delegatorAddress1, validatorCountProposal, true))
+	require.NoError(t, vote(delegatorAddress2, validatorCountProposal, false))
+	require.NoError(t, vote(delegatorAddress2, cycleProposal, true))
+	require.NoError(t, vote(delegatorAddress1, cycleProposal, false))
+	require.Error(t, vote(addr2, cycleProposal, true))
+
+	resp, err := dpos.Contract.GetProposal(
+		contractpb.WrapPluginStaticContext(dposCtx), &GetProposalRequest{ProposalId: validatorCountProposal},
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.NumVotes)
+	require.Equal(t, largeDelegationAmount.Int64(), resp.YesVotes.Value.Int64())
+	require.Equal(t, delegationAmount.Int64(), resp.NoVotes.Value.Int64())
+
+	// proposals aren't tallied until their voting period ends
+	require.NoError(t, elect(pctx, dpos.Address))
+	state, err := LoadState(contractpb.WrapPluginStaticContext(dposCtx))
+	require.NoError(t, err)
+	require.Equal(t, uint64(21), state.Params.ValidatorCount)
+
+	pctx.SetTime(pctx.Now().Add(proposalVotingPeriod * time.Second))
+	require.Error(t, vote(delegatorAddress1, cycleProposal, true))
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	state, err = LoadState(contractpb.WrapPluginStaticContext(dposCtx))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), state.Params.ValidatorCount)
+	require.NotEqual(t, int64(3600), state.Params.ElectionCycleLength)
+
+	proposals, err := dpos.Contract.ListProposals(
+		contractpb.WrapPluginStaticContext(dposCtx), &ListProposalsRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(proposals.Proposals))
+	require.Equal(t, ProposalStatus_EXECUTED, proposals.Proposals[0].Status)
+	totalStake := new(big.Int).Add(largeDelegationAmount, delegationAmount)
+	totalStake.Add(totalStake, smallDelegationAmount)
+	require.Equal(t, totalStake, proposals.Proposals[0].TotalStake.Value.Int)
+	require.Equal(t, ProposalStatus_REJECTED, proposals.Proposals[1].Status)
+
+	proposals, err = dpos.Contract.ListProposals(
+		contractpb.WrapPluginStaticContext(dposCtx), &ListProposalsRequest{ActiveOnly: true},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(proposals.Proposals))
+
+	// params that aren't part of the DPOS state can be changed too
+	feeChangeProposal, err := submit(delegatorAddress1, ProposalParam_MAX_FEE_CHANGE_PER_ELECTION, 1000)
+	require.NoError(t, err)
+	require.NoError(t, vote(delegatorAddress1, feeChangeProposal, true))
+	pctx.SetTime(pctx.Now().Add(proposalVotingPeriod * time.Second))
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	feeLimits, err := dpos.Contract.GetCandidateFeeLimits(
+		contractpb.WrapPluginStaticContext(dposCtx), &GetCandidateFeeLimitsRequest{Candidate: addr1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.Nil(t, feeLimits.Limits)
+	require.Equal(t, uint64(1000), feeLimits.MaxFeeChangePerElection)
+}
+
+func TestValidatorKeyRotation(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_18, true)
+
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 1000000000000000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:          21,
+		RegistrationRequirement: loom.BigZeroPB(),
+		OracleAddress:           addr4.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	require.NoError(t, dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil))
+	require.NoError(t, dpos.RegisterCandidate(pctx.WithSender(addr2), pubKey2, nil, nil, nil, nil, nil, nil))
+
+	delegationAmount := big.NewInt(10000000)
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, dpos.Delegate(pctx.WithSender(delegatorAddress1), &addr1, delegationAmount, nil, nil))
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	staticCtx := contractpb.WrapPluginStaticContext(dposCtx)
+	oldValidators, err := ValidatorList(staticCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(oldValidators))
+	require.Equal(t, pubKey1, oldValidators[0].PubKey)
+
+	rotate := func(pubKey []byte) error {
+		return dpos.Contract.RotateValidatorKey(
+			contractpb.WrapPluginContext(dposCtx.WithSender(addr1)),
+			&RotateValidatorKeyRequest{NewPubKey: pubKey},
+		)
+	}
+	// the key of another candidate can't be used
+	require.Error(t, rotate(pubKey2))
+	require.Error(t, rotate(pubKey1))
+	require.NoError(t, rotate(pubKey3))
+
+	resp, err := dpos.Contract.GetPendingKeyRotation(
+		staticCtx, &GetPendingKeyRotationRequest{Candidate: addr1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Rotation)
+	require.Equal(t, pubKey3, resp.Rotation.NewPubKey)
+
+	// the new key takes effect in the next election
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	newValidators, err := ValidatorList(staticCtx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(newValidators))
+	require.Equal(t, pubKey3, newValidators[0].PubKey)
+	removed := MissingValidators(oldValidators, newValidators)
+	require.Equal(t, 1, len(removed))
+	require.Equal(t, pubKey1, removed[0].PubKey)
+
+	resp, err = dpos.Contract.GetPendingKeyRotation(
+		staticCtx, &GetPendingKeyRotationRequest{Candidate: addr1.MarshalPB()},
+	)
+	require.NoError(t, err)
+	require.Nil(t, resp.Rotation)
+
+	// both the old & new keys still resolve to the candidate
+	require.Equal(t, 0, candidateAddressFromPubKey(staticCtx, pubKey3).Compare(addr1))
+	candidates, err := LoadCandidateList(staticCtx)
+	require.NoError(t, err)
+	for _, pubKey := range [][]byte{pubKey1, pubKey3} {
+		addr, err := GetLocalCandidateAddressFromTendermintAddress(
+			staticCtx, loom.LocalAddressFromPublicKeyV2(pubKey), candidates,
+		)
+		require.NoError(t, err)
+		require.Equal(t, 0, addr.Compare(addr1))
+	}
+
+	statistics, err := dpos.ListValidators(pctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(statistics))
+	require.Equal(t, 0, statistics[0].Address.Local.Compare(addr1.Local))
+
+	// the rotated key can't be used to register another candidate
+	err = dpos.RegisterCandidate(pctx.WithSender(addr3), pubKey3, nil, nil, nil, nil, nil, nil)
+	require.Error(t, err)
+}
+
 // UTILITIES
 
 func makeAccount(owner loom.Address, bal uint64) *coin.InitialAccount {
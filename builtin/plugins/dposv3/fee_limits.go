@@ -0,0 +1,321 @@
+package dposv3
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// CANDIDATE FEE LIMITS
+//
+// A candidate can commit to a maximum fee, and to a maximum amount by which its fee can be increased
+// in a single election. Once set the limits can only ever be tightened, so delegators can rely on
+// them when choosing a validator. A fee increase that exceeds the per-election limit is applied
+// gradually, one step per election, until the candidate's fee reaches the requested fee.
+// Fee decreases are never rate-limited.
+//
+// Candidates that haven't set any limits, or set a looser max fee change, are still subject to the
+// protocol-wide max fee change per election, which can be changed via governance.
+//
+// A candidate's limits outlive its registration, a candidate that unregisters and registers again
+// starts out at its previous fee, and any increase from there is scheduled as a regular fee change.
+
+// DefaultMaxFeeChangePerElection is the most any candidate's fee can be increased by in a single
+// election (in basis points) until governance changes the limit.
+const DefaultMaxFeeChangePerElection = uint64(500)
+
+var errFeeLimitsDisabled = errors.New("DPOS v3.14 is not enabled")
+
+// SetCandidateFeeLimits sets or tightens the fee limits of the sender.
+func (c *DPOS) SetCandidateFeeLimits(ctx contract.Context, req *SetCandidateFeeLimitsRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_14, false) {
+		return errFeeLimitsDisabled
+	}
+
+	candidateAddress := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 SetCandidateFeeLimits", "candidate", candidateAddress, "request", req)
+
+	cand := GetCandidate(ctx, candidateAddress)
+	if cand == nil {
+		return logDposError(ctx, errCandidateNotFound, req.String())
+	}
+
+	if err := validateFee(req.MaxFee); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+	if err := validateFee(req.MaxFeeChange); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+
+	highestFee := cand.Fee
+	if cand.NewFee > highestFee {
+		highestFee = cand.NewFee
+	}
+	pendingChange, err := loadPendingFeeChange(ctx, candidateAddress)
+	if err != nil {
+		return err
+	}
+	if pendingChange != nil && pendingChange.TargetFee > highestFee {
+		highestFee = pendingChange.TargetFee
+	}
+	if req.MaxFee < highestFee {
+		return logDposError(
+			ctx, fmt.Errorf("Max fee can't be lower than the current or pending fee %d", highestFee), req.String(),
+		)
+	}
+
+	limits, err := loadCandidateFeeLimits(ctx, candidateAddress)
+	if err != nil {
+		return err
+	}
+	if limits != nil && (req.MaxFee > limits.MaxFee || req.MaxFeeChange > limits.MaxFeeChange) {
+		return logDposError(ctx, errors.New("Fee limits can only be tightened"), req.String())
+	}
+
+	limits = &CandidateFeeLimits{
+		Candidate:    candidateAddress.MarshalPB(),
+		MaxFee:       req.MaxFee,
+		MaxFeeChange: req.MaxFeeChange,
+	}
+	if err := saveCandidateFeeLimits(ctx, limits); err != nil {
+		return err
+	}
+
+	return emitCandidateFeeLimitsEvent(ctx, limits)
+}
+
+// GetCandidateFeeLimits returns the fee limits of the given candidate, the limits in the response
+// will be nil if the candidate hasn't set any.
+func (c *DPOS) GetCandidateFeeLimits(
+	ctx contract.StaticContext, req *GetCandidateFeeLimitsRequest,
+) (*GetCandidateFeeLimitsResponse, error) {
+	if req.Candidate == nil {
+		return nil, errors.New("candidate address not specified")
+	}
+
+	limits, err := loadCandidateFeeLimits(ctx, loom.UnmarshalAddressPB(req.Candidate))
+	if err != nil {
+		return nil, err
+	}
+	maxFeeChange, err := loadMaxFeeChangePerElection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GetCandidateFeeLimitsResponse{Limits: limits, MaxFeeChangePerElection: maxFeeChange}, nil
+}
+
+// ListPendingFeeChanges returns all the fee changes that are still being applied.
+func (c *DPOS) ListPendingFeeChanges(
+	ctx contract.StaticContext, req *ListPendingFeeChangesRequest,
+) (*ListPendingFeeChangesResponse, error) {
+	candidates, err := LoadCandidateList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*PendingFeeChange, 0)
+	for _, cand := range candidates {
+		change, err := loadPendingFeeChange(ctx, loom.UnmarshalAddressPB(cand.Address))
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+	}
+	return &ListPendingFeeChangesResponse{Changes: changes}, nil
+}
+
+// scheduleFeeChange schedules the first step of a change to the given candidate's fee, the
+// remaining steps are scheduled by applyPendingFeeChange as each step is applied.
+func scheduleFeeChange(ctx contract.Context, cand *Candidate, fee uint64) error {
+	candidateAddress := loom.UnmarshalAddressPB(cand.Address)
+	limits, err := loadCandidateFeeLimits(ctx, candidateAddress)
+	if err != nil {
+		return err
+	}
+
+	if limits != nil {
+		if fee > limits.MaxFee {
+			return fmt.Errorf("Candidate fee can't be greater than %d", limits.MaxFee)
+		}
+		if fee > cand.Fee && limits.MaxFeeChange == 0 {
+			return errors.New("Candidate fee can't be increased")
+		}
+	}
+
+	nextFee, err := nextFeeChangeStep(ctx, cand.Fee, fee, limits)
+	if err != nil {
+		return err
+	}
+	cand.NewFee = nextFee
+	cand.State = ABOUT_TO_CHANGE_FEE
+
+	if nextFee == fee {
+		deletePendingFeeChange(ctx, candidateAddress)
+	} else {
+		err := savePendingFeeChange(ctx, &PendingFeeChange{
+			Candidate:   cand.Address,
+			TargetFee:   fee,
+			NextFee:     nextFee,
+			ScheduledAt: ctx.Block().Height,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return emitCandidateFeeChangeScheduledEvent(ctx, cand.Address, cand.Fee, nextFee, fee)
+}
+
+// registrationFee returns the fee a re-registering candidate starts out with, if the candidate has
+// fee limits the fee can't be raised above the fee it charged before it was unregistered in one
+// go, the rest of the increase must be scheduled via scheduleFeeChange.
+func registrationFee(ctx contract.StaticContext, candidate loom.Address, fee uint64) (uint64, error) {
+	limits, err := loadCandidateFeeLimits(ctx, candidate)
+	if err != nil {
+		return 0, err
+	}
+	if limits == nil {
+		return fee, nil
+	}
+	if fee > limits.MaxFee {
+		return 0, fmt.Errorf("Candidate fee can't be greater than %d", limits.MaxFee)
+	}
+	if fee > limits.LastFee {
+		return limits.LastFee, nil
+	}
+	return fee, nil
+}
+
+// recordCandidateLastFee stores the fee the given candidate charged before it was removed from the
+// candidate list, so its limits can be applied if it registers again.
+func recordCandidateLastFee(ctx contract.Context, cand *Candidate) error {
+	if cand == nil {
+		return nil
+	}
+	limits, err := loadCandidateFeeLimits(ctx, loom.UnmarshalAddressPB(cand.Address))
+	if err != nil || limits == nil {
+		return err
+	}
+	limits.LastFee = cand.Fee
+	return saveCandidateFeeLimits(ctx, limits)
+}
+
+// applyPendingFeeChange is called when a candidate's fee is changed at the end of an election,
+// and schedules the next step of the candidate's pending fee change (if there is one).
+func applyPendingFeeChange(ctx contract.Context, cand *Candidate, oldFee uint64) error {
+	candidateAddress := loom.UnmarshalAddressPB(cand.Address)
+	change, err := loadPendingFeeChange(ctx, candidateAddress)
+	if err != nil {
+		return err
+	}
+
+	targetFee := cand.Fee
+	if change != nil {
+		targetFee = change.TargetFee
+	}
+
+	if err := emitCandidateFeeChangeAppliedEvent(ctx, cand.Address, oldFee, cand.Fee, targetFee); err != nil {
+		return err
+	}
+
+	if cand.Fee == targetFee {
+		deletePendingFeeChange(ctx, candidateAddress)
+		return nil
+	}
+
+	limits, err := loadCandidateFeeLimits(ctx, candidateAddress)
+	if err != nil {
+		return err
+	}
+
+	nextFee, err := nextFeeChangeStep(ctx, cand.Fee, targetFee, limits)
+	if err != nil {
+		return err
+	}
+	if nextFee == cand.Fee {
+		// The limits were tightened after the change was scheduled, so no further progress can be made
+		deletePendingFeeChange(ctx, candidateAddress)
+		return nil
+	}
+
+	// The first step is delayed by two elections, the following steps are applied at the end of
+	// every election.
+	change.NextFee = nextFee
+	cand.NewFee = nextFee
+	cand.State = CHANGING_FEE
+	return savePendingFeeChange(ctx, change)
+}
+
+func nextFeeChangeStep(
+	ctx contract.StaticContext, currentFee, targetFee uint64, limits *CandidateFeeLimits,
+) (uint64, error) {
+	if targetFee <= currentFee {
+		return targetFee, nil
+	}
+	maxFeeChange, err := loadMaxFeeChangePerElection(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if limits != nil && limits.MaxFeeChange < maxFeeChange {
+		maxFeeChange = limits.MaxFeeChange
+	}
+	if targetFee-currentFee > maxFeeChange {
+		return currentFee + maxFeeChange, nil
+	}
+	return targetFee, nil
+}
+
+func emitCandidateFeeLimitsEvent(ctx contract.Context, limits *CandidateFeeLimits) error {
+	marshalled, err := proto.Marshal(&DposCandidateFeeLimitsEvent{
+		Candidate:    limits.Candidate,
+		MaxFee:       limits.MaxFee,
+		MaxFeeChange: limits.MaxFeeChange,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, CandidateFeeLimitsEventTopic)
+	return nil
+}
+
+func emitCandidateFeeChangeScheduledEvent(
+	ctx contract.Context, candidate *types.Address, currentFee, nextFee, targetFee uint64,
+) error {
+	marshalled, err := proto.Marshal(&DposCandidateFeeChangeScheduledEvent{
+		Candidate:  candidate,
+		CurrentFee: currentFee,
+		NextFee:    nextFee,
+		TargetFee:  targetFee,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, FeeChangeScheduledEventTopic)
+	return nil
+}
+
+func emitCandidateFeeChangeAppliedEvent(
+	ctx contract.Context, candidate *types.Address, oldFee, newFee, targetFee uint64,
+) error {
+	marshalled, err := proto.Marshal(&DposCandidateFeeChangeAppliedEvent{
+		Candidate: candidate,
+		OldFee:    oldFee,
+		NewFee:    newFee,
+		TargetFee: targetFee,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, FeeChangeAppliedEventTopic)
+	return nil
+}
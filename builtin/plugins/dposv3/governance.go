@@ -0,0 +1,453 @@
+package dposv3
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// GOVERNANCE
+//
+// When DPOS v3.17 is enabled any delegator with enough stake can submit a proposal to change one of
+// the DPOS parameters, and delegators can vote for or against the proposal until its voting period
+// ends. Votes are weighted by the voter's delegated stake at the time the proposal is tallied, which
+// happens at the first election after the voting period ends. A proposal passes if the stake that
+// voted on it reaches the quorum, and more stake voted for it than against it. Proposals that pass
+// are executed immediately, so the parameter change applies to the election that tallied them.
+//
+// The stake delegated by the liquid staking pools is owned by the DPOS contract, which can't vote,
+// so it's excluded from the total stake the quorum and the proposer requirement are computed from.
+
+const (
+	proposalVotingPeriod = 604800 // one week
+	// Percentage (in basis points) of the total delegated stake that must vote on a proposal
+	proposalQuorum = 3333
+	// Percentage (in basis points) of the total delegated stake the proposer must have delegated
+	minProposerStake    = 100
+	maxActiveProposals  = 10
+	maxProposalDescSize = 1024
+
+	// Bounds on the values proposals can set the parameters to
+	minProposedElectionCycleLength = 600     // ten minutes
+	maxProposedElectionCycleLength = 2592000 // 30 days
+	minProposedValidatorCount      = 4
+	maxProposedValidatorCount      = 100
+	minProposedDowntimePeriod      = 256       // blocks
+	maxProposedDowntimePeriod      = 1000000   // blocks
+	minProposedMaxYearlyReward     = 1000000   // tokens
+	maxProposedMaxYearlyReward     = 200000000 // tokens
+	minProposedMaxFeeChange        = 10        // basis points
+	maxProposedMaxFeeChange        = 2500      // basis points
+)
+
+var errGovernanceDisabled = errors.New("DPOS v3.17 is not enabled")
+
+// SubmitProposal creates a new parameter change proposal, the sender must have delegated at least
+// 1% of the total delegated stake, and can only have one proposal open for voting at a time.
+func (c *DPOS) SubmitProposal(ctx contract.Context, req *SubmitProposalRequest) (*SubmitProposalResponse, error) {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_17, false) {
+		return nil, errGovernanceDisabled
+	}
+
+	proposer := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 SubmitProposal", "proposer", proposer, "request", req)
+
+	if err := validateProposalValue(req.Param, req.Value); err != nil {
+		return nil, logDposError(ctx, err, req.String())
+	}
+	if len(req.Description) > maxProposalDescSize {
+		return nil, logDposError(ctx, errors.New("Proposal description is too long"), req.String())
+	}
+
+	stakes, totalStake, err := delegatorStakes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stake, ok := stakes[proposer.String()]
+	if !ok || !common.IsPositive(*stake) {
+		return nil, logDposError(ctx, errors.New("Only delegators can submit proposals"), req.String())
+	}
+	requiredStake := CalculateFraction(*loom.NewBigUIntFromInt(minProposerStake), *totalStake)
+	if stake.Cmp(&requiredStake) < 0 {
+		return nil, logDposError(ctx, errors.New("Insufficient stake to submit a proposal"), req.String())
+	}
+
+	state, err := loadGovernanceState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(state.ActiveProposalIds) >= maxActiveProposals {
+		return nil, logDposError(ctx, errors.New("Too many active proposals"), req.String())
+	}
+	for _, id := range state.ActiveProposalIds {
+		active, err := loadProposal(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if active != nil && loom.UnmarshalAddressPB(active.Proposer).Compare(proposer) == 0 {
+			return nil, logDposError(ctx, errors.New("Proposer already has an active proposal"), req.String())
+		}
+	}
+
+	now := ctx.Now().Unix()
+	proposal := &ParamChangeProposal{
+		Id:           state.NextProposalId,
+		Proposer:     proposer.MarshalPB(),
+		Param:        req.Param,
+		Value:        req.Value,
+		Description:  req.Description,
+		CreatedAt:    now,
+		VotingEndsAt: now + proposalVotingPeriod,
+		Status:       ProposalStatus_VOTING,
+	}
+	if err := saveProposal(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	state.NextProposalId++
+	state.ActiveProposalIds = append(state.ActiveProposalIds, proposal.Id)
+	if err := saveGovernanceState(ctx, state); err != nil {
+		return nil, err
+	}
+
+	if err := emitProposalSubmittedEvent(ctx, proposal); err != nil {
+		return nil, err
+	}
+	return &SubmitProposalResponse{ProposalId: proposal.Id}, nil
+}
+
+// VoteOnProposal records the sender's vote on a proposal, or changes it if the sender has already
+// voted on the proposal.
+func (c *DPOS) VoteOnProposal(ctx contract.Context, req *VoteOnProposalRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_17, false) {
+		return errGovernanceDisabled
+	}
+
+	voter := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 VoteOnProposal", "voter", voter, "request", req)
+
+	proposal, err := loadProposal(ctx, req.ProposalId)
+	if err != nil {
+		return err
+	}
+	if proposal == nil {
+		return logDposError(ctx, errors.New("Proposal not found"), req.String())
+	}
+	if proposal.Status != ProposalStatus_VOTING || ctx.Now().Unix() >= proposal.VotingEndsAt {
+		return logDposError(ctx, errors.New("Voting on the proposal has ended"), req.String())
+	}
+
+	stake, err := delegatorStake(ctx, voter)
+	if err != nil {
+		return err
+	}
+	if !common.IsPositive(*stake) {
+		return logDposError(ctx, errors.New("Only delegators can vote on proposals"), req.String())
+	}
+
+	return saveProposalVote(ctx, &ProposalVote{
+		ProposalId: proposal.Id,
+		Voter:      voter.MarshalPB(),
+		Approve:    req.Approve,
+	})
+}
+
+// GetProposal returns a proposal, and the stake currently backing each side of the vote.
+func (c *DPOS) GetProposal(ctx contract.StaticContext, req *GetProposalRequest) (*GetProposalResponse, error) {
+	proposal, err := loadProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, errors.New("proposal not found")
+	}
+
+	votes, err := loadProposalVotes(ctx, proposal.Id)
+	if err != nil {
+		return nil, err
+	}
+	stakes, _, err := delegatorStakes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	yes, no := tallyVotes(votes, stakes)
+	return &GetProposalResponse{
+		Proposal: proposal,
+		YesVotes: &types.BigUInt{Value: *yes},
+		NoVotes:  &types.BigUInt{Value: *no},
+		NumVotes: uint64(len(votes)),
+	}, nil
+}
+
+// ListProposals returns all proposals, or only those that are still being voted on.
+func (c *DPOS) ListProposals(ctx contract.StaticContext, req *ListProposalsRequest) (*ListProposalsResponse, error) {
+	state, err := loadGovernanceState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint64
+	if req.ActiveOnly {
+		ids = state.ActiveProposalIds
+	} else {
+		for id := uint64(1); id < state.NextProposalId; id++ {
+			ids = append(ids, id)
+		}
+	}
+
+	proposals := make([]*ParamChangeProposal, 0, len(ids))
+	for _, id := range ids {
+		proposal, err := loadProposal(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if proposal != nil {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return &ListProposalsResponse{Proposals: proposals}, nil
+}
+
+// tallyProposals tallies the votes on all the proposals whose voting period has ended, and applies
+// the parameter changes of the proposals that passed to the given state.
+func tallyProposals(ctx contract.Context, state *State) error {
+	govState, err := loadGovernanceState(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := ctx.Now().Unix()
+	var endedProposals []*ParamChangeProposal
+	activeProposalIds := make([]uint64, 0, len(govState.ActiveProposalIds))
+	for _, id := range govState.ActiveProposalIds {
+		proposal, err := loadProposal(ctx, id)
+		if err != nil {
+			return err
+		}
+		if proposal == nil {
+			continue
+		}
+		if now < proposal.VotingEndsAt {
+			activeProposalIds = append(activeProposalIds, id)
+			continue
+		}
+		endedProposals = append(endedProposals, proposal)
+	}
+	if len(endedProposals) == 0 {
+		return nil
+	}
+
+	stakes, totalStake, err := delegatorStakes(ctx)
+	if err != nil {
+		return err
+	}
+	quorum := CalculateFraction(*loom.NewBigUIntFromInt(proposalQuorum), *totalStake)
+
+	paramsChanged := false
+	for _, proposal := range endedProposals {
+		votes, err := loadProposalVotes(ctx, proposal.Id)
+		if err != nil {
+			return err
+		}
+		yes, no := tallyVotes(votes, stakes)
+		proposal.YesVotes = &types.BigUInt{Value: *yes}
+		proposal.NoVotes = &types.BigUInt{Value: *no}
+		proposal.TotalStake = &types.BigUInt{Value: *totalStake}
+
+		voted := common.BigZero()
+		voted.Add(yes, no)
+		if voted.Cmp(&quorum) >= 0 && yes.Cmp(no) > 0 {
+			changed, err := applyProposal(ctx, state.Params, proposal)
+			if err != nil {
+				return err
+			}
+			proposal.Status = ProposalStatus_EXECUTED
+			paramsChanged = paramsChanged || changed
+		} else {
+			proposal.Status = ProposalStatus_REJECTED
+		}
+
+		if err := saveProposal(ctx, proposal); err != nil {
+			return err
+		}
+		if err := emitProposalTalliedEvent(ctx, proposal); err != nil {
+			return err
+		}
+	}
+
+	govState.ActiveProposalIds = activeProposalIds
+	if err := saveGovernanceState(ctx, govState); err != nil {
+		return err
+	}
+
+	if paramsChanged {
+		return saveState(ctx, state)
+	}
+	return nil
+}
+
+func validateProposalValue(param ProposalParam, value *types.BigUInt) error {
+	if value == nil {
+		return errors.New("Proposal value not specified")
+	}
+
+	var minValue, maxValue *loom.BigUInt
+	switch param {
+	case ProposalParam_ELECTION_CYCLE_LENGTH:
+		minValue = loom.NewBigUIntFromInt(minProposedElectionCycleLength)
+		maxValue = loom.NewBigUIntFromInt(maxProposedElectionCycleLength)
+	case ProposalParam_VALIDATOR_COUNT:
+		minValue = loom.NewBigUIntFromInt(minProposedValidatorCount)
+		maxValue = loom.NewBigUIntFromInt(maxProposedValidatorCount)
+	case ProposalParam_DOWNTIME_PERIOD:
+		minValue = loom.NewBigUIntFromInt(minProposedDowntimePeriod)
+		maxValue = loom.NewBigUIntFromInt(maxProposedDowntimePeriod)
+	case ProposalParam_MAX_YEARLY_REWARD:
+		minValue = scientificNotation(minProposedMaxYearlyReward, tokenDecimals)
+		maxValue = scientificNotation(maxProposedMaxYearlyReward, tokenDecimals)
+	case ProposalParam_MAX_FEE_CHANGE_PER_ELECTION:
+		minValue = loom.NewBigUIntFromInt(minProposedMaxFeeChange)
+		maxValue = loom.NewBigUIntFromInt(maxProposedMaxFeeChange)
+	default:
+		return fmt.Errorf("unsupported proposal param %d", param)
+	}
+
+	if value.Value.Cmp(minValue) < 0 || value.Value.Cmp(maxValue) > 0 {
+		return fmt.Errorf("%s must be between %s and %s", param, minValue.String(), maxValue.String())
+	}
+	return nil
+}
+
+// Applies the param change of a proposal that passed, returns true if the change was made to the
+// given params (rather than to a param stored outside of the DPOS state).
+func applyProposal(ctx contract.Context, params *Params, proposal *ParamChangeProposal) (bool, error) {
+	switch proposal.Param {
+	case ProposalParam_ELECTION_CYCLE_LENGTH:
+		params.ElectionCycleLength = proposal.Value.Value.Int64()
+	case ProposalParam_VALIDATOR_COUNT:
+		params.ValidatorCount = proposal.Value.Value.Uint64()
+	case ProposalParam_MAX_YEARLY_REWARD:
+		params.MaxYearlyReward = &types.BigUInt{Value: proposal.Value.Value}
+	case ProposalParam_DOWNTIME_PERIOD:
+		params.DowntimePeriod = proposal.Value.Value.Uint64()
+	case ProposalParam_MAX_FEE_CHANGE_PER_ELECTION:
+		return false, saveMaxFeeChangePerElection(ctx, proposal.Value.Value.Uint64())
+	}
+	return true, nil
+}
+
+// Returns the total stake that voted for & against a proposal.
+func tallyVotes(votes []*ProposalVote, stakes map[string]*loom.BigUInt) (*loom.BigUInt, *loom.BigUInt) {
+	yes := common.BigZero()
+	no := common.BigZero()
+	for _, vote := range votes {
+		stake, ok := stakes[loom.UnmarshalAddressPB(vote.Voter).String()]
+		if !ok {
+			continue
+		}
+		if vote.Approve {
+			yes.Add(yes, stake)
+		} else {
+			no.Add(no, stake)
+		}
+	}
+	return yes, no
+}
+
+// Returns the amount delegated by each delegator, and the total amount delegated by all delegators,
+// excluding the liquid staking pool delegations.
+func delegatorStakes(ctx contract.StaticContext) (map[string]*loom.BigUInt, *loom.BigUInt, error) {
+	delegations, err := loadDelegationList(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stakes := map[string]*loom.BigUInt{}
+	total := common.BigZero()
+	for _, d := range delegations {
+		delegation, err := GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+		if err == contract.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+		if isLiquidStakeDelegation(ctx, delegation) {
+			continue
+		}
+
+		delegator := loom.UnmarshalAddressPB(d.Delegator).String()
+		stake, ok := stakes[delegator]
+		if !ok {
+			stake = common.BigZero()
+			stakes[delegator] = stake
+		}
+		stake.Add(stake, &delegation.Amount.Value)
+		total.Add(total, &delegation.Amount.Value)
+	}
+	return stakes, total, nil
+}
+
+// Returns the amount delegated by the given delegator.
+func delegatorStake(ctx contract.StaticContext, delegator loom.Address) (*loom.BigUInt, error) {
+	delegations, err := loadDelegationList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stake := common.BigZero()
+	for _, d := range delegations {
+		if loom.UnmarshalAddressPB(d.Delegator).Compare(delegator) != 0 {
+			continue
+		}
+		delegation, err := GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+		if err == contract.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		stake.Add(stake, &delegation.Amount.Value)
+	}
+	return stake, nil
+}
+
+func loadProposalVotes(ctx contract.StaticContext, id uint64) ([]*ProposalVote, error) {
+	votes := make([]*ProposalVote, 0)
+	for _, item := range ctx.Range(proposalVotesPrefix(id)) {
+		var vote ProposalVote
+		if err := proto.Unmarshal(item.Value, &vote); err != nil {
+			return nil, errors.Wrap(err, "unmarshal proposal vote")
+		}
+		votes = append(votes, &vote)
+	}
+	return votes, nil
+}
+
+func emitProposalSubmittedEvent(ctx contract.Context, proposal *ParamChangeProposal) error {
+	marshalled, err := proto.Marshal(&DposProposalSubmittedEvent{
+		Proposal: proposal,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, ProposalSubmittedEventTopic)
+	return nil
+}
+
+func emitProposalTalliedEvent(ctx contract.Context, proposal *ParamChangeProposal) error {
+	marshalled, err := proto.Marshal(&DposProposalTalliedEvent{
+		Proposal: proposal,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, ProposalTalliedEventTopic)
+	return nil
+}
@@ -0,0 +1,416 @@
+package dposv3
+
+import (
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// REWARD & DELEGATION HISTORY
+//
+// When DPOS v3.15 is enabled every election records the rewards credited to each delegator, the
+// total rewards distributed for delegations to each validator, and any changes to delegations
+// that took effect at the end of the election. History entries are keyed by account & election
+// time, so the history of an account can be paged through one election at a time without loading
+// the rest of it.
+//
+// Each election writes one entry per validator, one per delegator & validator pair that was
+// rewarded (rewards credited to multiple delegations to the same validator are added to a single
+// entry), and one per delegation that changed. Every entry is also indexed by the election it was
+// recorded in, which doubles the number of writes but allows the entries of the oldest elections
+// to be pruned without scanning the history of every account. So the cost of recording history is
+// proportional to the number of delegations rewardAndSlash already has to iterate over.
+//
+// History entries are only retained for HistoryRetentionPeriod, so the size of the history of each
+// delegator & validator is bounded.
+
+const (
+	defaultHistoryPageSize = 100
+	maxHistoryPageSize     = 1000
+	// Max number of elections a single history query scans, accounts whose history is sparse may
+	// get fewer entries than requested along with a cursor to continue from.
+	maxHistoryElectionsPerPage = 1000
+
+	// HistoryRetentionPeriod is the number of seconds history entries are retained for.
+	HistoryRetentionPeriod = int64(366 * 24 * 60 * 60)
+	// Max number of history entries pruned during a single election, limits the amount of work
+	// done in a single block when history has to catch up.
+	maxPrunedHistoryEntries = 10000
+)
+
+// GetRewardHistory returns a page of the reward history of a delegator (optionally restricted to a
+// single validator), or of a validator if the delegator isn't specified.
+func (c *DPOS) GetRewardHistory(
+	ctx contract.StaticContext, req *GetRewardHistoryRequest,
+) (*GetRewardHistoryResponse, error) {
+	var loadElection func(electionTime int64) ([]*RewardHistoryEntry, error)
+	if req.Delegator != nil {
+		delegator := loom.UnmarshalAddressPB(req.Delegator)
+		var validator *loom.Address
+		if req.Validator != nil {
+			addr := loom.UnmarshalAddressPB(req.Validator)
+			validator = &addr
+		}
+		loadElection = func(electionTime int64) ([]*RewardHistoryEntry, error) {
+			return loadDelegatorRewardHistory(ctx, delegator, electionTime, validator)
+		}
+	} else if req.Validator != nil {
+		validator := loom.UnmarshalAddressPB(req.Validator)
+		loadElection = func(electionTime int64) ([]*RewardHistoryEntry, error) {
+			return loadValidatorRewardHistory(ctx, validator, electionTime)
+		}
+	} else {
+		return nil, errors.New("delegator or validator address must be specified")
+	}
+
+	entries := make([]*RewardHistoryEntry, 0)
+	var electionEntries []*RewardHistoryEntry
+	nextCursor, err := pageHistory(
+		ctx, req.Cursor, req.Limit,
+		func(electionTime int64) (int, error) {
+			var err error
+			electionEntries, err = loadElection(electionTime)
+			return len(electionEntries), err
+		},
+		func(start, end int) {
+			entries = append(entries, electionEntries[start:end]...)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &GetRewardHistoryResponse{
+		Entries:    entries,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetDelegationHistory returns a page of the changes made to a delegator's delegations, optionally
+// restricted to delegations to a single validator.
+func (c *DPOS) GetDelegationHistory(
+	ctx contract.StaticContext, req *GetDelegationHistoryRequest,
+) (*GetDelegationHistoryResponse, error) {
+	if req.Delegator == nil {
+		return nil, errors.New("delegator address must be specified")
+	}
+
+	var validator *loom.Address
+	if req.Validator != nil {
+		addr := loom.UnmarshalAddressPB(req.Validator)
+		validator = &addr
+	}
+
+	delegator := loom.UnmarshalAddressPB(req.Delegator)
+	entries := make([]*DelegationHistoryEntry, 0)
+	var electionEntries []*DelegationHistoryEntry
+	nextCursor, err := pageHistory(
+		ctx, req.Cursor, req.Limit,
+		func(electionTime int64) (int, error) {
+			var err error
+			electionEntries, err = loadDelegationHistory(ctx, delegator, electionTime, validator)
+			return len(electionEntries), err
+		},
+		func(start, end int) {
+			entries = append(entries, electionEntries[start:end]...)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &GetDelegationHistoryResponse{
+		Entries:    entries,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// pageHistory pages through the history of an account one election at a time, starting at the
+// given cursor. loadElection must load the account's entries recorded in an election and return
+// the number of entries loaded, take is then called with the range of the loaded entries that
+// belong to the page. Only the elections the page spans are loaded. Returns the cursor the next
+// page starts at, or nil if there are no more entries.
+func pageHistory(
+	ctx contract.StaticContext, cursor *HistoryCursor, limit uint64,
+	loadElection func(electionTime int64) (int, error), take func(start, end int),
+) (*HistoryCursor, error) {
+	if limit == 0 {
+		limit = defaultHistoryPageSize
+	} else if limit > maxHistoryPageSize {
+		limit = maxHistoryPageSize
+	}
+
+	state, err := loadHistoryState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seq := state.FirstElection
+	skip := uint64(0)
+	// The election the cursor points to may have been pruned since the cursor was returned
+	if cursor != nil && cursor.Election >= state.FirstElection {
+		seq = cursor.Election
+		skip = cursor.Index
+	}
+
+	remaining := limit
+	for scanned := 0; seq < state.NextElection; seq++ {
+		if remaining == 0 || scanned == maxHistoryElectionsPerPage {
+			return &HistoryCursor{Election: seq}, nil
+		}
+		scanned++
+
+		var election HistoryElection
+		if err := ctx.Get(historyElectionKey(seq), &election); err != nil {
+			return nil, errors.Wrapf(err, "failed to load history election %d", seq)
+		}
+		numEntries, err := loadElection(election.ElectionTime)
+		if err != nil {
+			return nil, err
+		}
+		if skip >= uint64(numEntries) {
+			skip = 0
+			continue
+		}
+		end := skip + remaining
+		if end > uint64(numEntries) {
+			end = uint64(numEntries)
+		}
+		take(int(skip), int(end))
+		remaining -= end - skip
+		if end < uint64(numEntries) {
+			return &HistoryCursor{Election: seq, Index: end}, nil
+		}
+		skip = 0
+	}
+	return nil, nil
+}
+
+// Loads the rewards credited to a delegator in an election, optionally restricted to a single
+// validator.
+func loadDelegatorRewardHistory(
+	ctx contract.StaticContext, delegator loom.Address, electionTime int64, validator *loom.Address,
+) ([]*RewardHistoryEntry, error) {
+	prefix := util.PrefixKey(delegatorRewardHistoryPrefix, delegator.Bytes(), historyTimeBytes(electionTime))
+	var entries []*RewardHistoryEntry
+	for _, item := range ctx.Range(prefix) {
+		var entry RewardHistoryEntry
+		if err := proto.Unmarshal(item.Value, &entry); err != nil {
+			return nil, errors.Wrap(err, "unmarshal reward history entry")
+		}
+		if validator != nil && loom.UnmarshalAddressPB(entry.Validator).Compare(*validator) != 0 {
+			continue
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
+
+// Loads the rewards distributed for delegations to a validator in an election.
+func loadValidatorRewardHistory(
+	ctx contract.StaticContext, validator loom.Address, electionTime int64,
+) ([]*RewardHistoryEntry, error) {
+	var entry RewardHistoryEntry
+	err := ctx.Get(validatorRewardHistoryKey(validator, electionTime), &entry)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to load validator reward history entry")
+	}
+	return []*RewardHistoryEntry{&entry}, nil
+}
+
+// Loads the changes made to a delegator's delegations in an election, optionally restricted to
+// delegations to a single validator.
+func loadDelegationHistory(
+	ctx contract.StaticContext, delegator loom.Address, electionTime int64, validator *loom.Address,
+) ([]*DelegationHistoryEntry, error) {
+	prefix := util.PrefixKey(delegationHistoryPrefix, delegator.Bytes(), historyTimeBytes(electionTime))
+	var entries []*DelegationHistoryEntry
+	for _, item := range ctx.Range(prefix) {
+		var entry DelegationHistoryEntry
+		if err := proto.Unmarshal(item.Value, &entry); err != nil {
+			return nil, errors.Wrap(err, "unmarshal delegation history entry")
+		}
+		if validator != nil && loom.UnmarshalAddressPB(entry.Validator).Compare(*validator) != 0 {
+			continue
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
+
+func isHistoryEnabled(ctx contract.StaticContext) bool {
+	return ctx.FeatureEnabled(features.DPOSVersion3_15, false)
+}
+
+// recordDelegatorReward adds the given amount to the rewards credited to a delegator for
+// delegations to a validator in the current election.
+func recordDelegatorReward(ctx contract.Context, validator, delegator *types.Address, amount loom.BigUInt) error {
+	if !isHistoryEnabled(ctx) || common.IsZero(amount) {
+		return nil
+	}
+
+	electionTime := ctx.Now().Unix()
+	key := delegatorRewardHistoryKey(
+		loom.UnmarshalAddressPB(delegator), electionTime, loom.UnmarshalAddressPB(validator),
+	)
+	var entry RewardHistoryEntry
+	err := ctx.Get(key, &entry)
+	if err != nil && err != contract.ErrNotFound {
+		return err
+	}
+	total := common.BigZero()
+	if entry.Amount != nil {
+		total.Add(total, &entry.Amount.Value)
+	} else if err := indexHistoryEntry(ctx, electionTime, key); err != nil {
+		// Only new entries need to be indexed, rewards credited to other delegations to the same
+		// validator are added to the existing entry.
+		return err
+	}
+	total.Add(total, &amount)
+
+	return ctx.Set(key, &RewardHistoryEntry{
+		ElectionTime: electionTime,
+		BlockHeight:  ctx.Block().Height,
+		Validator:    validator,
+		Delegator:    delegator,
+		Amount:       &types.BigUInt{Value: *total},
+	})
+}
+
+// recordValidatorReward records the total rewards distributed for delegations to a validator in
+// the current election.
+func recordValidatorReward(ctx contract.Context, validator *types.Address, total, validatorShare loom.BigUInt) error {
+	if !isHistoryEnabled(ctx) {
+		return nil
+	}
+
+	electionTime := ctx.Now().Unix()
+	key := validatorRewardHistoryKey(loom.UnmarshalAddressPB(validator), electionTime)
+	if err := indexHistoryEntry(ctx, electionTime, key); err != nil {
+		return err
+	}
+	return ctx.Set(key, &RewardHistoryEntry{
+		ElectionTime:   electionTime,
+		BlockHeight:    ctx.Block().Height,
+		Validator:      validator,
+		Amount:         &types.BigUInt{Value: total},
+		ValidatorShare: &types.BigUInt{Value: validatorShare},
+	})
+}
+
+// recordDelegationChange records a change to a delegation that took effect in the current
+// election, the delegation should already reflect the change.
+func recordDelegationChange(
+	ctx contract.Context, delegation *Delegation, change DelegationChange, amount loom.BigUInt,
+	previousValidator *types.Address,
+) error {
+	if !isHistoryEnabled(ctx) || common.IsZero(amount) {
+		return nil
+	}
+
+	electionTime := ctx.Now().Unix()
+	key := delegationHistoryKey(
+		loom.UnmarshalAddressPB(delegation.Delegator), electionTime,
+		loom.UnmarshalAddressPB(delegation.Validator), delegation.Index,
+	)
+	if err := indexHistoryEntry(ctx, electionTime, key); err != nil {
+		return err
+	}
+	return ctx.Set(key, &DelegationHistoryEntry{
+		ElectionTime:      electionTime,
+		BlockHeight:       ctx.Block().Height,
+		Validator:         delegation.Validator,
+		Delegator:         delegation.Delegator,
+		Index:             delegation.Index,
+		Change:            change,
+		Amount:            &types.BigUInt{Value: amount},
+		Balance:           delegation.Amount,
+		PreviousValidator: previousValidator,
+	})
+}
+
+// indexHistoryEntry adds a history entry to the index of the election it was recorded in.
+func indexHistoryEntry(ctx contract.Context, electionTime int64, key []byte) error {
+	state, err := loadHistoryState(ctx)
+	if err != nil {
+		return err
+	}
+	if state.NextElection == state.FirstElection || state.LastElectionTime != electionTime {
+		if err := ctx.Set(
+			historyElectionKey(state.NextElection), &HistoryElection{ElectionTime: electionTime},
+		); err != nil {
+			return err
+		}
+		state.NextElection++
+		state.LastElectionTime = electionTime
+		if err := ctx.Set(historyStateKey, state); err != nil {
+			return err
+		}
+	}
+	return ctx.Set(historyIndexKey(electionTime, key), &HistoryElection{ElectionTime: electionTime})
+}
+
+// pruneHistory deletes the history entries recorded by elections that are older than the
+// retention period, this should be called once per election. At most maxPrunedHistoryEntries are
+// deleted per call, an election whose entries can't all be deleted in one call is pruned over
+// multiple calls.
+func pruneHistory(ctx contract.Context) error {
+	if !isHistoryEnabled(ctx) {
+		return nil
+	}
+
+	state, err := loadHistoryState(ctx)
+	if err != nil {
+		return err
+	}
+
+	cutoff := ctx.Now().Unix() - HistoryRetentionPeriod
+	pruned := 0
+	prunedElections := 0
+	for state.FirstElection < state.NextElection && pruned < maxPrunedHistoryEntries {
+		var election HistoryElection
+		if err := ctx.Get(historyElectionKey(state.FirstElection), &election); err != nil {
+			return errors.Wrapf(err, "failed to load history election %d", state.FirstElection)
+		}
+		if election.ElectionTime >= cutoff {
+			break
+		}
+		items := ctx.Range(util.PrefixKey(historyIndexPrefix, historyTimeBytes(election.ElectionTime)))
+		deleted := 0
+		for _, item := range items {
+			if pruned == maxPrunedHistoryEntries {
+				break
+			}
+			ctx.Delete(item.Key)
+			ctx.Delete(historyIndexKey(election.ElectionTime, item.Key))
+			pruned++
+			deleted++
+		}
+		if deleted < len(items) {
+			// The rest of the election's entries will be pruned by the next call
+			break
+		}
+		ctx.Delete(historyElectionKey(state.FirstElection))
+		state.FirstElection++
+		prunedElections++
+	}
+
+	if prunedElections == 0 {
+		return nil
+	}
+	return ctx.Set(historyStateKey, state)
+}
+
+func loadHistoryState(ctx contract.StaticContext) (*HistoryState, error) {
+	var state HistoryState
+	err := ctx.Get(historyStateKey, &state)
+	if err != nil && err != contract.ErrNotFound {
+		return nil, errors.Wrap(err, "failed to load history state")
+	}
+	return &state, nil
+}
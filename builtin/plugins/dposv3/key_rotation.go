@@ -0,0 +1,235 @@
+package dposv3
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// VALIDATOR KEY ROTATION
+//
+// When DPOS v3.18 is enabled a candidate can replace the key its validator signs blocks with,
+// without unregistering. The new key takes effect in the first election after the rotation is
+// requested, at which point the old key is removed from the Tendermint validator set and the new
+// key is added in its place. Since the candidate address is no longer derived from the validator
+// key once the key has been rotated, every key a candidate has rotated to or from is recorded so
+// the candidate can still be resolved from the key (including any blocks signed with the old key
+// before Tendermint applies the validator set change).
+
+const ed25519PubKeySize = 32
+
+var errKeyRotationDisabled = errors.New("DPOS v3.18 is not enabled")
+
+// RotateValidatorKey requests that the sender's validator key be replaced in the next election,
+// a previously requested rotation that hasn't taken effect yet is replaced.
+func (c *DPOS) RotateValidatorKey(ctx contract.Context, req *RotateValidatorKeyRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+		return errKeyRotationDisabled
+	}
+
+	candidateAddress := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 RotateValidatorKey", "candidate", candidateAddress, "request", req)
+
+	cand := GetCandidate(ctx, candidateAddress)
+	if cand == nil {
+		return logDposError(ctx, errCandidateNotFound, req.String())
+	} else if cand.State == UNREGISTERING {
+		return logDposError(ctx, errCandidateUnregistering, req.String())
+	}
+
+	if len(req.NewPubKey) != ed25519PubKeySize {
+		return logDposError(ctx, errors.New("Invalid public key"), req.String())
+	}
+	if bytes.Equal(cand.PubKey, req.NewPubKey) {
+		return logDposError(ctx, errors.New("New public key must differ from the current one"), req.String())
+	}
+	if err := checkValidatorKeyUnused(ctx, req.NewPubKey, candidateAddress); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+
+	return savePendingKeyRotation(ctx, &PendingKeyRotation{
+		Candidate:   cand.Address,
+		NewPubKey:   req.NewPubKey,
+		RequestedAt: ctx.Block().Height,
+	})
+}
+
+// GetPendingKeyRotation returns the key rotation requested by a candidate that hasn't taken effect
+// yet, the rotation in the response will be nil if there isn't one.
+func (c *DPOS) GetPendingKeyRotation(
+	ctx contract.StaticContext, req *GetPendingKeyRotationRequest,
+) (*GetPendingKeyRotationResponse, error) {
+	if req.Candidate == nil {
+		return nil, errors.New("candidate address not specified")
+	}
+
+	rotation, err := loadPendingKeyRotation(ctx, loom.UnmarshalAddressPB(req.Candidate))
+	if err != nil {
+		return nil, err
+	}
+	return &GetPendingKeyRotationResponse{Rotation: rotation}, nil
+}
+
+// ResolveValidatorKeys returns the address of the candidate each of the given validator keys belongs
+// to, including keys the candidates have rotated away from.
+func (c *DPOS) ResolveValidatorKeys(
+	ctx contract.StaticContext, req *ResolveValidatorKeysRequest,
+) (*ResolveValidatorKeysResponse, error) {
+	candidates := make([]*types.Address, 0, len(req.PubKeys))
+	for _, pubKey := range req.PubKeys {
+		candidates = append(candidates, candidateAddressFromPubKey(ctx, pubKey).MarshalPB())
+	}
+	return &ResolveValidatorKeysResponse{Candidates: candidates}, nil
+}
+
+// CheckValidatorKeyActive returns an error if a candidate has rotated the given validator key to
+// another key, once the rotation takes effect the old key must no longer be used to sign blocks.
+func CheckValidatorKeyActive(ctx contract.StaticContext, pubKey []byte) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+		return nil
+	}
+
+	record, err := loadValidatorKeyRecord(ctx, loom.LocalAddressFromPublicKeyV2(pubKey))
+	if err != nil {
+		return err
+	}
+	if record == nil {
+		return nil
+	}
+	candidateAddress := loom.UnmarshalAddressPB(record.Candidate)
+	cand := GetCandidate(ctx, candidateAddress)
+	if cand != nil && !bytes.Equal(cand.PubKey, pubKey) {
+		return fmt.Errorf(
+			"validator key of candidate %s has been rotated, the node must be restarted with the new key",
+			candidateAddress,
+		)
+	}
+	return nil
+}
+
+// applyKeyRotations replaces the validator keys of all the candidates that requested a key
+// rotation, this must be done before the new validator set is elected.
+func applyKeyRotations(ctx contract.Context) error {
+	var rotations []*PendingKeyRotation
+	for _, item := range ctx.Range(pendingKeyRotationPrefix) {
+		var rotation PendingKeyRotation
+		if err := proto.Unmarshal(item.Value, &rotation); err != nil {
+			return errors.Wrap(err, "unmarshal pending key rotation")
+		}
+		rotations = append(rotations, &rotation)
+	}
+	if len(rotations) == 0 {
+		return nil
+	}
+
+	candidates, err := LoadCandidateList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rotation := range rotations {
+		candidateAddress := loom.UnmarshalAddressPB(rotation.Candidate)
+		deletePendingKeyRotation(ctx, candidateAddress)
+
+		cand := candidates.Get(candidateAddress)
+		if cand == nil {
+			continue
+		}
+		// Another candidate may have registered with the new key after the rotation was requested
+		if keyInUse(candidates, rotation.NewPubKey) {
+			ctx.Logger().Error("DPOSv3 validator key rotation skipped, key already in use", "candidate", candidateAddress)
+			continue
+		}
+
+		oldPubKey := cand.PubKey
+		for _, pubKey := range [][]byte{oldPubKey, rotation.NewPubKey} {
+			err := saveValidatorKeyRecord(ctx, &ValidatorKeyRecord{
+				Candidate: cand.Address,
+				PubKey:    pubKey,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		cand.PubKey = rotation.NewPubKey
+
+		if err := emitValidatorKeyRotatedEvent(ctx, cand, oldPubKey); err != nil {
+			return err
+		}
+	}
+
+	return saveCandidateList(ctx, candidates)
+}
+
+// checkValidatorKeyUnused returns an error if the given key is (or was) used by any candidate, or
+// is about to be rotated to by a candidate other than the given one.
+func checkValidatorKeyUnused(ctx contract.StaticContext, pubKey []byte, candidateAddress loom.Address) error {
+	errKeyInUse := errors.New("Public key is already in use")
+
+	if GetCandidateByPubKey(ctx, pubKey) != nil {
+		return errKeyInUse
+	}
+	keyAddr := loom.Address{ChainID: ctx.Block().ChainID, Local: loom.LocalAddressFromPublicKey(pubKey)}
+	if GetCandidate(ctx, keyAddr) != nil {
+		return errKeyInUse
+	}
+	record, err := loadValidatorKeyRecord(ctx, loom.LocalAddressFromPublicKeyV2(pubKey))
+	if err != nil {
+		return err
+	}
+	if record != nil {
+		return errKeyInUse
+	}
+
+	for _, item := range ctx.Range(pendingKeyRotationPrefix) {
+		var rotation PendingKeyRotation
+		if err := proto.Unmarshal(item.Value, &rotation); err != nil {
+			return errors.Wrap(err, "unmarshal pending key rotation")
+		}
+		if bytes.Equal(rotation.NewPubKey, pubKey) &&
+			loom.UnmarshalAddressPB(rotation.Candidate).Compare(candidateAddress) != 0 {
+			return errKeyInUse
+		}
+	}
+	return nil
+}
+
+func keyInUse(candidates CandidateList, pubKey []byte) bool {
+	for _, cand := range candidates {
+		if bytes.Equal(cand.PubKey, pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// candidateAddressFromPubKey returns the address of the candidate a validator key belongs to.
+func candidateAddressFromPubKey(ctx contract.StaticContext, pubKey []byte) loom.Address {
+	if ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+		record, err := loadValidatorKeyRecord(ctx, loom.LocalAddressFromPublicKeyV2(pubKey))
+		if err == nil && record != nil {
+			return loom.UnmarshalAddressPB(record.Candidate)
+		}
+	}
+	return loom.Address{ChainID: ctx.Block().ChainID, Local: loom.LocalAddressFromPublicKey(pubKey)}
+}
+
+func emitValidatorKeyRotatedEvent(ctx contract.Context, cand *Candidate, oldPubKey []byte) error {
+	marshalled, err := proto.Marshal(&DposValidatorKeyRotatedEvent{
+		Candidate: cand.Address,
+		OldPubKey: oldPubKey,
+		NewPubKey: cand.PubKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, ValidatorKeyRotatedEventTopic)
+	return nil
+}
@@ -0,0 +1,716 @@
+package dposv3
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// LIQUID STAKING
+//
+// Liquid stake delegated to a validator is pooled in delegations owned by the DPOS contract itself,
+// in return the liquid staker receives shares of the pool. Shares can be redeemed for the tokens
+// they're worth at any time, the rewards earned by the pool delegations increase the value of each
+// share. The pool delegations are tier zero delegations, so liquid stake can only be redeemed once
+// the two week lock on the pool delegation has expired.
+//
+// The shares of each pool are a token implemented by the DPOS contract itself, with ERC20 style
+// TransferLiquidStake, ApproveLiquidStake, TransferLiquidStakeFrom, and LiquidStakeAllowance
+// methods, every change in ownership emits a transfer event. The DPOS contract can't mint & burn
+// Coin or ERC20 tokens, so the shares aren't issued via either of those.
+//
+// The amount a redemption is owed is fixed when the shares are redeemed, and the stake it's owed
+// from is excluded from the pool value while it unbonds. Any slashing of that stake reduces the
+// redemption, not the value of the remaining shares.
+
+var (
+	errLiquidStakingDisabled   = errors.New("DPOS v3.11 is not enabled")
+	errLiquidStakePoolDepleted = errors.New("liquid stake pool has no value left")
+)
+
+// DelegateLiquid delegates tokens to the liquid stake pool of a validator, and credits the sender
+// with the corresponding number of pool shares.
+func (c *DPOS) DelegateLiquid(ctx contract.Context, req *DelegateLiquidRequest) (*DelegateLiquidResponse, error) {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_11, false) {
+		return nil, errLiquidStakingDisabled
+	}
+
+	owner := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 DelegateLiquid", "owner", owner, "request", req)
+
+	if req.ValidatorAddress == nil {
+		return nil, logDposError(ctx, errors.New("DelegateLiquid called with req.ValidatorAddress == nil"), req.String())
+	}
+
+	validator := loom.UnmarshalAddressPB(req.ValidatorAddress)
+	cand := GetCandidate(ctx, validator)
+	if cand == nil {
+		return nil, logDposError(ctx, errCandidateNotFound, req.String())
+	} else if cand.State == UNREGISTERING {
+		return nil, logDposError(ctx, errCandidateUnregistering, req.String())
+	}
+
+	if req.Amount == nil || !common.IsPositive(req.Amount.Value) {
+		return nil, logDposError(ctx, errors.New("Must Delegate a positive number of tokens."), req.String())
+	}
+
+	pool, err := loadLiquidStakePool(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+	poolValue, err := liquidStakePoolValue(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	// Once the pool has been slashed down to nothing its shares are worthless, minting new shares
+	// at any price would hand part of the new stake to the holders of the worthless shares.
+	if common.IsPositive(pool.TotalShares.Value) && common.IsZero(*poolValue) {
+		return nil, logDposError(ctx, errLiquidStakePoolDepleted, req.String())
+	}
+	shares := calculateLiquidShares(req.Amount.Value, pool.TotalShares.Value, *poolValue)
+	if !common.IsPositive(shares) {
+		return nil, logDposError(ctx, errors.New("Delegation amount too small to mint any shares."), req.String())
+	}
+
+	coin, err := loadCoin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = coin.TransferFrom(owner, ctx.ContractAddress(), &req.Amount.Value)
+	if err != nil {
+		transferFromErr := fmt.Sprintf("Failed coin TransferFrom - DelegateLiquid, %v, %s", owner.String(), req.Amount.Value.String())
+		return nil, logDposError(ctx, err, transferFromErr)
+	}
+
+	if err := bondLiquidStake(ctx, req.ValidatorAddress, req.Amount.Value); err != nil {
+		return nil, err
+	}
+
+	balance, err := loadLiquidStakeBalance(ctx, validator, owner)
+	if err != nil {
+		return nil, err
+	}
+	balance.Shares.Value.Add(&balance.Shares.Value, &shares)
+	pool.TotalShares.Value.Add(&pool.TotalShares.Value, &shares)
+
+	if err := saveLiquidStakeBalance(ctx, balance); err != nil {
+		return nil, err
+	}
+	if err := saveLiquidStakePool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	sharesPB := &types.BigUInt{Value: shares}
+	if err := emitLiquidDelegatesEvent(ctx, owner.MarshalPB(), req.ValidatorAddress, req.Amount, sharesPB); err != nil {
+		return nil, err
+	}
+
+	return &DelegateLiquidResponse{Shares: sharesPB}, nil
+}
+
+// RedeemLiquidStake burns the given number of the sender's pool shares in exchange for the tokens
+// they're worth. Tokens that have already been unbonded from the pool are paid out immediately,
+// the rest is unbonded from the pool delegations and paid out at the end of the next election.
+func (c *DPOS) RedeemLiquidStake(ctx contract.Context, req *RedeemLiquidStakeRequest) (*RedeemLiquidStakeResponse, error) {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_11, false) {
+		return nil, errLiquidStakingDisabled
+	}
+
+	owner := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 RedeemLiquidStake", "owner", owner, "request", req)
+
+	if req.ValidatorAddress == nil {
+		return nil, logDposError(ctx, errors.New("RedeemLiquidStake called with req.ValidatorAddress == nil"), req.String())
+	}
+	if req.Shares == nil || !common.IsPositive(req.Shares.Value) {
+		return nil, logDposError(ctx, errors.New("Must redeem a positive number of shares."), req.String())
+	}
+
+	validator := loom.UnmarshalAddressPB(req.ValidatorAddress)
+	balance, err := loadLiquidStakeBalance(ctx, validator, owner)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Shares.Value.Cmp(&req.Shares.Value) < 0 {
+		return nil, logDposError(ctx, errors.New("Redeemed shares exceed balance."), req.String())
+	}
+
+	pool, err := loadLiquidStakePool(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+	poolValue, err := liquidStakePoolValue(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	amount := calculateLiquidValue(req.Shares.Value, pool.TotalShares.Value, *poolValue)
+
+	balance.Shares.Value.Sub(&balance.Shares.Value, &req.Shares.Value)
+	pool.TotalShares.Value.Sub(&pool.TotalShares.Value, &req.Shares.Value)
+
+	// pay out as much as possible from the tokens that have already been unbonded from the pool
+	paid := common.BigZero()
+	paid.Add(paid, &amount)
+	if paid.Cmp(&pool.Unbonded.Value) > 0 {
+		paid = common.BigZero()
+		paid.Add(paid, &pool.Unbonded.Value)
+	}
+	pending := common.BigZero()
+	pending.Sub(&amount, paid)
+
+	if common.IsPositive(*paid) {
+		pool.Unbonded.Value.Sub(&pool.Unbonded.Value, paid)
+		coin, err := loadCoin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := coin.Transfer(owner, paid); err != nil {
+			transferErr := fmt.Sprintf("Failed coin Transfer - RedeemLiquidStake, %v, %s", owner.String(), paid.String())
+			return nil, logDposError(ctx, err, transferErr)
+		}
+	}
+
+	if common.IsPositive(*pending) {
+		if err := unbondLiquidStake(ctx, req.ValidatorAddress, *pending); err != nil {
+			return nil, logDposError(ctx, err, req.String())
+		}
+		if err := queueLiquidRedemption(ctx, &LiquidRedemption{
+			Owner:     owner.MarshalPB(),
+			Validator: req.ValidatorAddress,
+			Amount:    &types.BigUInt{Value: *pending},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := saveLiquidStakeBalance(ctx, balance); err != nil {
+		return nil, err
+	}
+	if err := saveLiquidStakePool(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	if err := emitLiquidRedeemsEvent(
+		ctx, owner.MarshalPB(), req.ValidatorAddress, req.Shares, &types.BigUInt{Value: amount},
+	); err != nil {
+		return nil, err
+	}
+
+	return &RedeemLiquidStakeResponse{
+		Paid:    &types.BigUInt{Value: *paid},
+		Pending: &types.BigUInt{Value: *pending},
+	}, nil
+}
+
+// TransferLiquidStake transfers pool shares from the sender to another account.
+func (c *DPOS) TransferLiquidStake(ctx contract.Context, req *TransferLiquidStakeRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_11, false) {
+		return errLiquidStakingDisabled
+	}
+
+	from := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 TransferLiquidStake", "from", from, "request", req)
+
+	if req.ValidatorAddress == nil || req.To == nil {
+		return logDposError(ctx, errors.New("TransferLiquidStake called with missing address"), req.String())
+	}
+	if req.Shares == nil || !common.IsPositive(req.Shares.Value) {
+		return logDposError(ctx, errors.New("Must transfer a positive number of shares."), req.String())
+	}
+
+	if err := transferLiquidShares(
+		ctx, req.ValidatorAddress, from, loom.UnmarshalAddressPB(req.To), req.Shares,
+	); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+	return nil
+}
+
+// ApproveLiquidStake allows the spender to transfer up to the given number of the sender's pool
+// shares via TransferLiquidStakeFrom, replacing any previous allowance.
+func (c *DPOS) ApproveLiquidStake(ctx contract.Context, req *ApproveLiquidStakeRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_11, false) {
+		return errLiquidStakingDisabled
+	}
+
+	owner := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 ApproveLiquidStake", "owner", owner, "request", req)
+
+	if req.ValidatorAddress == nil || req.Spender == nil {
+		return logDposError(ctx, errors.New("ApproveLiquidStake called with missing address"), req.String())
+	}
+	if req.Shares == nil {
+		return logDposError(ctx, errors.New("ApproveLiquidStake called with req.Shares == nil"), req.String())
+	}
+
+	spender := loom.UnmarshalAddressPB(req.Spender)
+	allowance, err := loadLiquidStakeAllowance(ctx, loom.UnmarshalAddressPB(req.ValidatorAddress), owner, spender)
+	if err != nil {
+		return err
+	}
+	allowance.Shares = req.Shares
+	if err := saveLiquidStakeAllowance(ctx, allowance); err != nil {
+		return err
+	}
+
+	return emitLiquidApprovalEvent(ctx, owner.MarshalPB(), req.Spender, req.ValidatorAddress, req.Shares)
+}
+
+// TransferLiquidStakeFrom transfers pool shares from one account to another, the sender must have
+// been approved by the owner of the shares to transfer at least the given number of shares.
+func (c *DPOS) TransferLiquidStakeFrom(ctx contract.Context, req *TransferLiquidStakeFromRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_11, false) {
+		return errLiquidStakingDisabled
+	}
+
+	spender := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 TransferLiquidStakeFrom", "spender", spender, "request", req)
+
+	if req.ValidatorAddress == nil || req.From == nil || req.To == nil {
+		return logDposError(ctx, errors.New("TransferLiquidStakeFrom called with missing address"), req.String())
+	}
+	if req.Shares == nil || !common.IsPositive(req.Shares.Value) {
+		return logDposError(ctx, errors.New("Must transfer a positive number of shares."), req.String())
+	}
+
+	from := loom.UnmarshalAddressPB(req.From)
+	allowance, err := loadLiquidStakeAllowance(ctx, loom.UnmarshalAddressPB(req.ValidatorAddress), from, spender)
+	if err != nil {
+		return err
+	}
+	if allowance.Shares.Value.Cmp(&req.Shares.Value) < 0 {
+		return logDposError(ctx, errors.New("Transferred shares exceed allowance."), req.String())
+	}
+
+	if err := transferLiquidShares(
+		ctx, req.ValidatorAddress, from, loom.UnmarshalAddressPB(req.To), req.Shares,
+	); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+
+	allowance.Shares.Value.Sub(&allowance.Shares.Value, &req.Shares.Value)
+	return saveLiquidStakeAllowance(ctx, allowance)
+}
+
+// LiquidStakeAllowance returns the number of the owner's pool shares the spender is allowed to
+// transfer.
+func (c *DPOS) LiquidStakeAllowance(
+	ctx contract.StaticContext, req *LiquidStakeAllowanceRequest,
+) (*LiquidStakeAllowanceResponse, error) {
+	if req.ValidatorAddress == nil || req.Owner == nil || req.Spender == nil {
+		return nil, errors.New("LiquidStakeAllowance called with missing address")
+	}
+
+	allowance, err := loadLiquidStakeAllowance(
+		ctx,
+		loom.UnmarshalAddressPB(req.ValidatorAddress),
+		loom.UnmarshalAddressPB(req.Owner),
+		loom.UnmarshalAddressPB(req.Spender),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &LiquidStakeAllowanceResponse{Shares: allowance.Shares}, nil
+}
+
+// Moves pool shares from one account to another.
+func transferLiquidShares(
+	ctx contract.Context, validatorAddress *types.Address, from, to loom.Address, shares *types.BigUInt,
+) error {
+	if from.Compare(to) == 0 {
+		return errors.New("Cannot transfer shares to self.")
+	}
+
+	validator := loom.UnmarshalAddressPB(validatorAddress)
+	fromBalance, err := loadLiquidStakeBalance(ctx, validator, from)
+	if err != nil {
+		return err
+	}
+	if fromBalance.Shares.Value.Cmp(&shares.Value) < 0 {
+		return errors.New("Transferred shares exceed balance.")
+	}
+	toBalance, err := loadLiquidStakeBalance(ctx, validator, to)
+	if err != nil {
+		return err
+	}
+
+	fromBalance.Shares.Value.Sub(&fromBalance.Shares.Value, &shares.Value)
+	toBalance.Shares.Value.Add(&toBalance.Shares.Value, &shares.Value)
+
+	if err := saveLiquidStakeBalance(ctx, fromBalance); err != nil {
+		return err
+	}
+	if err := saveLiquidStakeBalance(ctx, toBalance); err != nil {
+		return err
+	}
+
+	return emitLiquidTransferEvent(ctx, from.MarshalPB(), to.MarshalPB(), validatorAddress, shares)
+}
+
+// CheckLiquidStake returns the number of pool shares owned by an account, and their current value.
+func (c *DPOS) CheckLiquidStake(ctx contract.StaticContext, req *CheckLiquidStakeRequest) (*CheckLiquidStakeResponse, error) {
+	if req.ValidatorAddress == nil {
+		return nil, errors.New("CheckLiquidStake called with req.ValidatorAddress == nil")
+	}
+
+	owner := ctx.Message().Sender
+	if req.Owner != nil {
+		owner = loom.UnmarshalAddressPB(req.Owner)
+	}
+
+	validator := loom.UnmarshalAddressPB(req.ValidatorAddress)
+	balance, err := loadLiquidStakeBalance(ctx, validator, owner)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := loadLiquidStakePool(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+	poolValue, err := liquidStakePoolValue(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CheckLiquidStakeResponse{
+		Shares:    balance.Shares,
+		Value:     &types.BigUInt{Value: calculateLiquidValue(balance.Shares.Value, pool.TotalShares.Value, *poolValue)},
+		Pool:      pool,
+		PoolValue: &types.BigUInt{Value: *poolValue},
+	}, nil
+}
+
+// ListLiquidRedemptions returns the liquid stake redemptions that will be paid out at the end of
+// the next election.
+func (c *DPOS) ListLiquidRedemptions(ctx contract.StaticContext, req *ListLiquidRedemptionsRequest) (*ListLiquidRedemptionsResponse, error) {
+	redemptions, err := loadLiquidRedemptionList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ListLiquidRedemptionsResponse{Redemptions: redemptions.Redemptions}, nil
+}
+
+// Returns true if the delegation belongs to a liquid stake pool.
+func isLiquidStakeDelegation(ctx contract.StaticContext, delegation *Delegation) bool {
+	return loom.UnmarshalAddressPB(delegation.Delegator).Compare(ctx.ContractAddress()) == 0
+}
+
+// Returns the number of tokens the shares of the given pool are worth, which is the amount bonded
+// (or bonding) to the validator by the pool, and any tokens unbonded from the pool that aren't
+// owed to redemptions. Tokens that are currently unbonding, or have been unbonded but not paid out
+// yet, are owed to redemptions, so they're not included.
+func liquidStakePoolValue(ctx contract.StaticContext, pool *LiquidStakePool) (*loom.BigUInt, error) {
+	delegations, err := returnMatchingDelegations(ctx, pool.Validator, ctx.ContractAddress().MarshalPB())
+	if err != nil {
+		return nil, err
+	}
+
+	total := common.BigZero()
+	for _, delegation := range delegations {
+		total.Add(total, &delegation.Amount.Value)
+		if delegation.State == BONDING {
+			total.Add(total, &delegation.UpdateAmount.Value)
+		} else if delegation.State == UNBONDING {
+			total.Sub(total, &delegation.UpdateAmount.Value)
+		}
+	}
+	total.Add(total, &pool.Unbonded.Value)
+	return total, nil
+}
+
+// Returns the number of shares minted for the given amount of tokens, the first delegation to a
+// pool mints one share per token. No shares are minted if the pool has shares but no value.
+func calculateLiquidShares(amount, totalShares, poolValue loom.BigUInt) loom.BigUInt {
+	shares := *common.BigZero()
+	if common.IsZero(totalShares) {
+		shares.Add(&shares, &amount)
+		return shares
+	}
+	if common.IsZero(poolValue) {
+		return shares
+	}
+	shares.Mul(&amount, &totalShares)
+	shares.Div(&shares, &poolValue)
+	return shares
+}
+
+// Returns the number of tokens the given number of shares is worth.
+func calculateLiquidValue(shares, totalShares, poolValue loom.BigUInt) loom.BigUInt {
+	value := *common.BigZero()
+	if common.IsZero(totalShares) {
+		return value
+	}
+	value.Mul(&shares, &poolValue)
+	value.Div(&value, &totalShares)
+	return value
+}
+
+// Adds the given amount to the pool delegation that's currently bonding, or creates a new pool
+// delegation if there isn't one.
+func bondLiquidStake(ctx contract.Context, validator *types.Address, amount loom.BigUInt) error {
+	poolAddress := ctx.ContractAddress().MarshalPB()
+	delegations, err := returnMatchingDelegations(ctx, validator, poolAddress)
+	if err != nil {
+		return err
+	}
+
+	lockTime := uint64(ctx.Now().Unix()) + TierLocktimeMap[TIER_ZERO]
+
+	for _, delegation := range delegations {
+		if delegation.Index != REWARD_DELEGATION_INDEX && delegation.State == BONDING {
+			updatedAmount := common.BigZero()
+			updatedAmount.Add(&delegation.UpdateAmount.Value, &amount)
+			delegation.UpdateAmount = &types.BigUInt{Value: *updatedAmount}
+			delegation.LockTime = lockTime
+			return SetDelegation(ctx, delegation)
+		}
+	}
+
+	index, err := GetNextDelegationIndex(ctx, *validator, *poolAddress)
+	if err != nil {
+		return err
+	}
+
+	return SetDelegation(ctx, &Delegation{
+		Validator:    validator,
+		Delegator:    poolAddress,
+		Amount:       loom.BigZeroPB(),
+		UpdateAmount: &types.BigUInt{Value: amount},
+		LocktimeTier: TIER_ZERO,
+		LockTime:     lockTime,
+		State:        BONDING,
+		Index:        index,
+	})
+}
+
+// Unbonds the given amount from unlocked pool delegations.
+func unbondLiquidStake(ctx contract.Context, validator *types.Address, amount loom.BigUInt) error {
+	state, err := LoadState(ctx)
+	if err != nil {
+		return err
+	}
+
+	instantUnlock := state.Params.ElectionCycleLength == 0
+	if !instantUnlock && ctx.FeatureEnabled(features.DPOSVersion3_9, false) {
+		instantUnlock = state.Params.IgnoreUnbondLocktime
+	}
+
+	delegations, err := returnMatchingDelegations(ctx, validator, ctx.ContractAddress().MarshalPB())
+	if err != nil {
+		return err
+	}
+
+	now := uint64(ctx.Now().Unix())
+	remaining := common.BigZero()
+	remaining.Add(remaining, &amount)
+	for _, delegation := range delegations {
+		if common.IsZero(*remaining) {
+			break
+		}
+
+		available := common.BigZero()
+		if delegation.State == BONDED {
+			if delegation.LockTime > now && !instantUnlock {
+				continue
+			}
+			available.Add(available, &delegation.Amount.Value)
+			delegation.UpdateAmount = loom.BigZeroPB()
+		} else if delegation.State == UNBONDING {
+			available.Sub(&delegation.Amount.Value, &delegation.UpdateAmount.Value)
+		} else {
+			continue
+		}
+
+		if available.Cmp(remaining) > 0 {
+			available = common.BigZero()
+			available.Add(available, remaining)
+		}
+		if !common.IsPositive(*available) {
+			continue
+		}
+
+		updateAmount := common.BigZero()
+		updateAmount.Add(&delegation.UpdateAmount.Value, available)
+		delegation.UpdateAmount = &types.BigUInt{Value: *updateAmount}
+		delegation.State = UNBONDING
+		if err := SetDelegation(ctx, delegation); err != nil {
+			return err
+		}
+		remaining.Sub(remaining, available)
+	}
+
+	if common.IsPositive(*remaining) {
+		return errors.Wrap(errDelegationLocked, "not enough unlocked liquid stake")
+	}
+	return nil
+}
+
+// Credits the given pool with tokens unbonded from one of its delegations during an election, pool
+// delegations are only unbonded to pay out redemptions so these tokens are owed to the queued
+// redemptions of the pool.
+func addLiquidStakeUnbonded(ctx contract.Context, validator *types.Address, amount loom.BigUInt) error {
+	pool, err := loadLiquidStakePool(ctx, loom.UnmarshalAddressPB(validator))
+	if err != nil {
+		return err
+	}
+	pool.Redeemed.Value.Add(&pool.Redeemed.Value, &amount)
+	return saveLiquidStakePool(ctx, pool)
+}
+
+// Pays out the queued liquid stake redemptions from the tokens unbonded from the pools, this
+// should be called during an election after the pool delegations have been unbonded. The amount
+// owed to each redemption is fixed when it's queued, and the stake it's owed from is unbonded by
+// the end of the next election, so every queued redemption is paid out by this function.
+//
+// If a pool delegation is slashed while stake is unbonding from it the unbonding stake is slashed
+// too, in which case the redemptions from that pool are paid out pro-rata from the tokens that
+// were actually unbonded. That way the redeemers bear the loss on the stake they redeemed, rather
+// than the remaining shareholders.
+func payLiquidRedemptions(ctx contract.Context) error {
+	redemptions, err := loadLiquidRedemptionList(ctx)
+	if err != nil {
+		return err
+	}
+	if len(redemptions.Redemptions) == 0 {
+		return nil
+	}
+
+	coin, err := loadCoin(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Total amount owed by each pool, and the tokens unbonded from each pool to pay it
+	var poolKeys []string
+	pools := map[string]*LiquidStakePool{}
+	owed := map[string]*loom.BigUInt{}
+	redeemed := map[string]*loom.BigUInt{}
+	for _, r := range redemptions.Redemptions {
+		validator := loom.UnmarshalAddressPB(r.Validator)
+		key := validator.String()
+		if _, ok := pools[key]; !ok {
+			pool, err := loadLiquidStakePool(ctx, validator)
+			if err != nil {
+				return err
+			}
+			poolKeys = append(poolKeys, key)
+			pools[key] = pool
+			owed[key] = common.BigZero()
+			redeemed[key] = common.BigZero()
+			redeemed[key].Add(redeemed[key], &pool.Redeemed.Value)
+		}
+		owed[key].Add(owed[key], &r.Amount.Value)
+	}
+
+	for _, r := range redemptions.Redemptions {
+		key := loom.UnmarshalAddressPB(r.Validator).String()
+		pool := pools[key]
+
+		amount := common.BigZero()
+		amount.Add(amount, &r.Amount.Value)
+		if redeemed[key].Cmp(owed[key]) < 0 {
+			amount.Mul(amount, redeemed[key])
+			amount.Div(amount, owed[key])
+			ctx.Logger().Info("DPOSv3 liquid redemption reduced by slashing",
+				"owner", r.Owner, "validator", r.Validator, "owed", r.Amount.Value.String(),
+				"paid", amount.String())
+		}
+		if !common.IsPositive(*amount) {
+			continue
+		}
+
+		pool.Redeemed.Value.Sub(&pool.Redeemed.Value, amount)
+		owner := loom.UnmarshalAddressPB(r.Owner)
+		if err := coin.Transfer(owner, amount); err != nil {
+			transferErr := fmt.Sprintf("Failed coin Transfer - payLiquidRedemptions, %v, %s", owner.String(), amount.String())
+			return logDposError(ctx, err, transferErr)
+		}
+	}
+
+	// Whatever is left over after rounding belongs to the pool.
+	for _, key := range poolKeys {
+		pool := pools[key]
+		pool.Unbonded.Value.Add(&pool.Unbonded.Value, &pool.Redeemed.Value)
+		pool.Redeemed = loom.BigZeroPB()
+		if err := saveLiquidStakePool(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	return saveLiquidRedemptionList(ctx, &LiquidRedemptionList{})
+}
+
+func emitLiquidDelegatesEvent(
+	ctx contract.Context, owner, validator *types.Address, amount, shares *types.BigUInt,
+) error {
+	marshalled, err := proto.Marshal(&DposLiquidDelegatesEvent{
+		Owner:     owner,
+		Validator: validator,
+		Amount:    amount,
+		Shares:    shares,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, LiquidDelegatesEventTopic)
+	return nil
+}
+
+func emitLiquidRedeemsEvent(
+	ctx contract.Context, owner, validator *types.Address, shares, amount *types.BigUInt,
+) error {
+	marshalled, err := proto.Marshal(&DposLiquidRedeemsEvent{
+		Owner:     owner,
+		Validator: validator,
+		Shares:    shares,
+		Amount:    amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, LiquidRedeemsEventTopic)
+	return nil
+}
+
+func emitLiquidTransferEvent(
+	ctx contract.Context, from, to, validator *types.Address, shares *types.BigUInt,
+) error {
+	marshalled, err := proto.Marshal(&DposLiquidTransferEvent{
+		From:      from,
+		To:        to,
+		Validator: validator,
+		Shares:    shares,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, LiquidTransferEventTopic)
+	return nil
+}
+
+func emitLiquidApprovalEvent(
+	ctx contract.Context, owner, spender, validator *types.Address, shares *types.BigUInt,
+) error {
+	marshalled, err := proto.Marshal(&DposLiquidApprovalEvent{
+		Owner:     owner,
+		Spender:   spender,
+		Validator: validator,
+		Shares:    shares,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, LiquidApprovalEventTopic)
+	return nil
+}
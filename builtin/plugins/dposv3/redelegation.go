@@ -0,0 +1,409 @@
+package dposv3
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// REDELEGATION LIMITS
+//
+// When DPOS v3.16 is enabled redelegation requests are no longer applied immediately, instead they're
+// added to a queue that's processed at the start of each election. The oracle can limit how often
+// a redelegation can be requested for each delegation, and how much of a validator's delegation
+// total can be redelegated to other validators in a single election. A queued redelegation that
+// would exceed the latter limit is split, the part that fits within the limit is applied and the
+// rest remains in the queue until a later election. Stake that's waiting in the queue stays bonded
+// to the former validator, so it's still subject to that validator's slashing.
+
+var errRedelegationLimitsDisabled = errors.New("DPOS v3.16 is not enabled")
+
+// SetRedelegationLimits sets the redelegation cooldown & per-election redelegation limit, can only
+// be called by the oracle.
+func (c *DPOS) SetRedelegationLimits(ctx contract.Context, req *SetRedelegationLimitsRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+		return errRedelegationLimitsDisabled
+	}
+
+	sender := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 SetRedelegationLimits", "sender", sender, "request", req)
+
+	state, err := LoadState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+		return logDposError(ctx, errOnlyOracle, req.String())
+	}
+
+	if req.Limits == nil {
+		return logDposError(ctx, errors.New("Redelegation limits not specified"), req.String())
+	}
+	if req.Limits.Cooldown < 0 {
+		return logDposError(ctx, errors.New("Redelegation cooldown can't be negative"), req.String())
+	}
+	if req.Limits.MaxRedelegationPercentage > hundredPercentInBasisPoints {
+		return logDposError(
+			ctx, errors.New("Max redelegation percentage cannot be greater than 100%"), req.String(),
+		)
+	}
+
+	return saveRedelegationLimits(ctx, req.Limits)
+}
+
+// ListRedelegationQueue returns the redelegations that haven't been applied yet, along with the
+// current redelegation limits.
+func (c *DPOS) ListRedelegationQueue(
+	ctx contract.StaticContext, req *ListRedelegationQueueRequest,
+) (*ListRedelegationQueueResponse, error) {
+	queue, err := loadRedelegationQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := loadRedelegationLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	redelegations := make([]*QueuedRedelegation, 0, len(queue))
+	for _, r := range queue {
+		if req.Delegator != nil &&
+			loom.UnmarshalAddressPB(r.Delegator).Compare(loom.UnmarshalAddressPB(req.Delegator)) != 0 {
+			continue
+		}
+		redelegations = append(redelegations, r)
+	}
+	return &ListRedelegationQueueResponse{
+		Redelegations: redelegations,
+		Limits:        limits,
+	}, nil
+}
+
+// queueRedelegation adds a redelegation request to the redelegation queue, the delegation must
+// already be known to be BONDED.
+func queueRedelegation(ctx contract.Context, delegation *Delegation, req *RedelegateRequest) error {
+	if req.Amount != nil && delegation.Amount.Value.Cmp(&req.Amount.Value) < 0 {
+		return logDposError(ctx, errors.New("Redelegation amount out of range."), req.String())
+	}
+
+	limits, err := loadRedelegationLimits(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := ctx.Now().Unix()
+	if limits != nil && limits.Cooldown > 0 {
+		cooldown, err := loadRedelegationCooldown(ctx, delegation)
+		if err != nil {
+			return err
+		}
+		if cooldown != nil && now < cooldown.LastRedelegation+limits.Cooldown {
+			msg := fmt.Sprintf(
+				"Redelegation cooldown hasn't elapsed, next redelegation is possible at %d",
+				cooldown.LastRedelegation+limits.Cooldown,
+			)
+			return logDposError(ctx, errors.New(msg), req.String())
+		}
+	}
+
+	if hasQueuedRedelegation(ctx, delegation) {
+		return logDposError(ctx, errors.New("Delegation already has a queued redelegation."), req.String())
+	}
+
+	redelegation := &QueuedRedelegation{
+		Delegator:       delegation.Delegator,
+		FormerValidator: delegation.Validator,
+		Validator:       req.ValidatorAddress,
+		Index:           delegation.Index,
+		Amount:          req.Amount,
+		NewLocktimeTier: req.NewLocktimeTier,
+		Referrer:        req.Referrer,
+		QueuedAt:        now,
+	}
+	if err := enqueueRedelegation(ctx, redelegation); err != nil {
+		return err
+	}
+
+	err = saveRedelegationCooldown(ctx, &RedelegationCooldown{
+		Delegator:        delegation.Delegator,
+		Validator:        delegation.Validator,
+		Index:            delegation.Index,
+		LastRedelegation: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	return emitRedelegationQueuedEvent(ctx, redelegation)
+}
+
+// processRedelegationQueue applies queued redelegations in the order they were requested, until the
+// per-election redelegation limit of each former validator is reached. Redelegations that can no
+// longer be applied (e.g. because the delegation was unbonded in the meantime) are dropped.
+func processRedelegationQueue(ctx contract.Context) error {
+	queue, err := loadRedelegationQueue(ctx)
+	if err != nil {
+		return err
+	}
+	if len(queue) == 0 {
+		return nil
+	}
+
+	limits, err := loadRedelegationLimits(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Amount that can still be redelegated away from each validator in this election, the limit is
+	// derived from the total amount delegated to the validator as of the last election. The
+	// validator's DelegationTotal can't be used since it's weighted by the locktime of each
+	// delegation.
+	var delegationTotals map[string]*loom.BigUInt
+	remaining := map[string]*loom.BigUInt{}
+	getRemaining := func(validator loom.Address) (*loom.BigUInt, error) {
+		if limits == nil || limits.MaxRedelegationPercentage == 0 {
+			return nil, nil
+		}
+		if amount, ok := remaining[validator.String()]; ok {
+			return amount, nil
+		}
+		if delegationTotals == nil {
+			var err error
+			if delegationTotals, err = delegationAmountTotals(ctx); err != nil {
+				return nil, err
+			}
+		}
+		amount := common.BigZero()
+		if total, ok := delegationTotals[validator.String()]; ok {
+			fraction := CalculateFraction(
+				*loom.NewBigUIntFromInt(int64(limits.MaxRedelegationPercentage)), *total,
+			)
+			amount = &fraction
+		}
+		remaining[validator.String()] = amount
+		return amount, nil
+	}
+
+	c := &DPOS{}
+	for _, r := range queue {
+		formerValidator := loom.UnmarshalAddressPB(r.FormerValidator)
+		delegation, err := GetDelegation(ctx, r.Index, *r.FormerValidator, *r.Delegator)
+		if err == contract.ErrNotFound {
+			ctx.Logger().Info("DPOSv3 dropping queued redelegation of missing delegation", "redelegation", r)
+			dequeueRedelegation(ctx, r)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if reason := checkQueuedRedelegation(ctx, r, delegation); reason != "" {
+			ctx.Logger().Info("DPOSv3 dropping queued redelegation", "redelegation", r, "reason", reason)
+			dequeueRedelegation(ctx, r)
+			continue
+		}
+
+		req := &RedelegateRequest{
+			ValidatorAddress:       r.Validator,
+			FormerValidatorAddress: r.FormerValidator,
+			Index:                  r.Index,
+			Amount:                 r.Amount,
+			NewLocktimeTier:        r.NewLocktimeTier,
+			Referrer:               r.Referrer,
+		}
+
+		amount := delegation.Amount.Value
+		if r.Amount != nil {
+			amount = r.Amount.Value
+		}
+		available, err := getRemaining(formerValidator)
+		if err != nil {
+			return err
+		}
+		requested := amount
+		split := false
+		if available != nil {
+			if !common.IsPositive(*available) {
+				continue
+			}
+			if available.Cmp(&amount) < 0 {
+				// Only part of the redelegation fits within the limit, the rest stays queued.
+				split = true
+				amount = *common.BigZero()
+				amount.Add(&amount, available)
+				req.Amount = &types.BigUInt{Value: amount}
+			}
+			available.Sub(available, &amount)
+		}
+
+		// Redelegating part of a delegation creates a new delegation for the part that's moved,
+		// which inherits the cooldown of the delegation it was split from.
+		partial := req.Amount != nil && delegation.Amount.Value.Cmp(&req.Amount.Value) > 0
+		var newIndex uint64
+		if partial {
+			newIndex, err = GetNextDelegationIndex(ctx, *r.Validator, *r.Delegator)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The redelegation has already been validated by checkQueuedRedelegation, so any error at
+		// this point fails the election rather than leaving a partially applied redelegation behind.
+		if err := c.applyRedelegation(ctx, delegation, req); err != nil {
+			return errors.Wrapf(err, "failed to apply queued redelegation %d", r.Seq)
+		}
+		if partial {
+			err = saveRedelegationCooldown(ctx, &RedelegationCooldown{
+				Delegator:        r.Delegator,
+				Validator:        r.Validator,
+				Index:            newIndex,
+				LastRedelegation: r.QueuedAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !split {
+			dequeueRedelegation(ctx, r)
+			continue
+		}
+		// A full redelegation remains a full redelegation of whatever is left of the delegation.
+		if r.Amount != nil {
+			remainder := common.BigZero()
+			remainder.Sub(&requested, &amount)
+			r.Amount = &types.BigUInt{Value: *remainder}
+		}
+		if err := updateQueuedRedelegation(ctx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reindexRedelegationRecords is called when a delegation is re-created with a new index at the end
+// of an election, so that the cooldown of the delegation follows it. If the delegation stayed with
+// the same validator it must be the remainder of a partially applied queued redelegation, in which
+// case the queued redelegation has to follow the delegation too.
+func reindexRedelegationRecords(
+	ctx contract.Context, oldValidator, newValidator, delegator *types.Address, oldIndex, newIndex uint64,
+) error {
+	oldValidatorAddr := loom.UnmarshalAddressPB(oldValidator)
+	newValidatorAddr := loom.UnmarshalAddressPB(newValidator)
+	delegatorAddr := loom.UnmarshalAddressPB(delegator)
+
+	cooldown, err := loadRedelegationCooldown(ctx, &Delegation{
+		Validator: oldValidator,
+		Delegator: delegator,
+		Index:     oldIndex,
+	})
+	if err != nil {
+		return err
+	}
+	if cooldown != nil {
+		ctx.Delete(redelegationCooldownKey(oldValidatorAddr, delegatorAddr, oldIndex))
+		cooldown.Validator = newValidator
+		cooldown.Index = newIndex
+		if err := saveRedelegationCooldown(ctx, cooldown); err != nil {
+			return err
+		}
+	}
+
+	if oldValidatorAddr.Compare(newValidatorAddr) != 0 {
+		return nil
+	}
+	refKey := redelegationQueueRefKey(oldValidatorAddr, delegatorAddr, oldIndex)
+	var ref QueuedRedelegationRef
+	if err := ctx.Get(refKey, &ref); err == contract.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var r QueuedRedelegation
+	if err := ctx.Get(redelegationQueueKey(ref.Seq), &r); err != nil {
+		return errors.Wrapf(err, "failed to load queued redelegation %d", ref.Seq)
+	}
+
+	ctx.Delete(refKey)
+	if err := ctx.Set(redelegationQueueRefKey(newValidatorAddr, delegatorAddr, newIndex), &ref); err != nil {
+		return err
+	}
+	r.Index = newIndex
+	return updateQueuedRedelegation(ctx, &r)
+}
+
+// Returns the reason a queued redelegation can no longer be applied, or an empty string if it can.
+func checkQueuedRedelegation(ctx contract.StaticContext, r *QueuedRedelegation, delegation *Delegation) string {
+	if delegation.State != BONDED {
+		return "delegation is not BONDED"
+	}
+	if r.Amount != nil && delegation.Amount.Value.Cmp(&r.Amount.Value) < 0 {
+		return "redelegation amount exceeds delegation amount"
+	}
+	validator := loom.UnmarshalAddressPB(r.Validator)
+	if validator.Compare(LimboValidatorAddress(ctx)) != 0 {
+		candidate := GetCandidate(ctx, validator)
+		if candidate == nil {
+			return "validator is no longer a candidate"
+		} else if candidate.State == UNREGISTERING {
+			return "validator is unregistering"
+		}
+	}
+	return ""
+}
+
+// Returns the sum of the (unweighted) amounts delegated to each validator.
+func delegationAmountTotals(ctx contract.StaticContext) (map[string]*loom.BigUInt, error) {
+	delegations, err := loadDelegationList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	totals := map[string]*loom.BigUInt{}
+	for _, d := range delegations {
+		delegation, err := GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+		if err == contract.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		validatorKey := loom.UnmarshalAddressPB(d.Validator).String()
+		total, ok := totals[validatorKey]
+		if !ok {
+			total = common.BigZero()
+			totals[validatorKey] = total
+		}
+		total.Add(total, &delegation.Amount.Value)
+	}
+	return totals, nil
+}
+
+// Returns the queued redelegations in the order they were queued.
+func loadRedelegationQueue(ctx contract.StaticContext) ([]*QueuedRedelegation, error) {
+	entries := ctx.Range(redelegationQueuePrefix)
+	queue := make([]*QueuedRedelegation, 0, len(entries))
+	for _, entry := range entries {
+		var r QueuedRedelegation
+		if err := proto.Unmarshal(entry.Value, &r); err != nil {
+			return nil, errors.Wrap(err, "unmarshal queued redelegation")
+		}
+		queue = append(queue, &r)
+	}
+	return queue, nil
+}
+
+func emitRedelegationQueuedEvent(ctx contract.Context, redelegation *QueuedRedelegation) error {
+	marshalled, err := proto.Marshal(&DposRedelegationQueuedEvent{
+		Redelegation: redelegation,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, RedelegationQueuedEventTopic)
+	return nil
+}
@@ -2,6 +2,7 @@ package dposv3
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"sort"
@@ -30,12 +31,163 @@ var (
 	requestBatchTallyKey   = []byte("request_batch_tally")
 	deprecatedReferrersKey = []byte("referrers")
 	referrerPrefix         = []byte("rf")
+
+	liquidStakePoolPrefix      = []byte("lsp")
+	liquidStakeBalancePrefix   = []byte("lsb")
+	liquidStakeAllowancePrefix = []byte("lsa")
+	liquidRedemptionsKey       = []byte("liquid_redemptions")
+
+	autoCompoundPrefix = []byte("ac")
+
+	byzantineEvidencePrefix = []byte("be")
+
+	candidateFeeLimitsPrefix = []byte("cfl")
+	pendingFeeChangePrefix   = []byte("pfc")
+	feeChangeParamsKey       = []byte("fee_change_params")
+
+	delegatorRewardHistoryPrefix = []byte("rhd")
+	validatorRewardHistoryPrefix = []byte("rhv")
+	delegationHistoryPrefix      = []byte("dh")
+	historyStateKey              = []byte("history_state")
+	historyElectionPrefix        = []byte("hse")
+	historyIndexPrefix           = []byte("hsi")
+
+	redelegationLimitsKey      = []byte("redelegation_limits")
+	redelegationQueueStateKey  = []byte("redelegation_queue_state")
+	redelegationQueuePrefix    = []byte("rdq")
+	redelegationQueueRefPrefix = []byte("rqr")
+	redelegationCooldownPrefix = []byte("rdc")
+
+	governanceStateKey = []byte("governance")
+	proposalPrefix     = []byte("gp")
+	proposalVotePrefix = []byte("gv")
+
+	pendingKeyRotationPrefix = []byte("pkr")
+	validatorKeyRecordPrefix = []byte("vkr")
 )
 
 func referrerKey(referrerName string) []byte {
 	return util.PrefixKey([]byte(referrerPrefix), []byte(referrerName))
 }
 
+func liquidStakePoolKey(validator loom.Address) []byte {
+	return util.PrefixKey(liquidStakePoolPrefix, validator.Bytes())
+}
+
+func liquidStakeBalanceKey(validator, owner loom.Address) []byte {
+	return util.PrefixKey(liquidStakeBalancePrefix, validator.Bytes(), owner.Bytes())
+}
+
+func liquidStakeAllowanceKey(validator, owner, spender loom.Address) []byte {
+	return util.PrefixKey(liquidStakeAllowancePrefix, validator.Bytes(), owner.Bytes(), spender.Bytes())
+}
+
+func autoCompoundKey(validator, delegator loom.Address) []byte {
+	return util.PrefixKey(autoCompoundPrefix, validator.Bytes(), delegator.Bytes())
+}
+
+func byzantineEvidenceKey(validator loom.Address, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height))
+	return util.PrefixKey(byzantineEvidencePrefix, validator.Bytes(), heightBytes)
+}
+
+func candidateFeeLimitsKey(candidate loom.Address) []byte {
+	return util.PrefixKey(candidateFeeLimitsPrefix, candidate.Bytes())
+}
+
+func pendingFeeChangeKey(candidate loom.Address) []byte {
+	return util.PrefixKey(pendingFeeChangePrefix, candidate.Bytes())
+}
+
+// Queued redelegations are keyed by sequence number (in big-endian) so they're ranged over in the
+// order they were queued.
+func redelegationQueueKey(seq uint64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, seq)
+	return util.PrefixKey(redelegationQueuePrefix, seqBytes)
+}
+
+func redelegationQueueRefKey(validator, delegator loom.Address, index uint64) []byte {
+	return util.PrefixKey(redelegationQueueRefPrefix, delegationKeySuffix(validator, delegator, index))
+}
+
+func redelegationCooldownKey(validator, delegator loom.Address, index uint64) []byte {
+	return util.PrefixKey(redelegationCooldownPrefix, delegationKeySuffix(validator, delegator, index))
+}
+
+// Returns a key suffix that uniquely identifies a delegation.
+func delegationKeySuffix(validator, delegator loom.Address, index uint64) []byte {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	return util.PrefixKey(validator.Bytes(), delegator.Bytes(), indexBytes)
+}
+
+func proposalIDBytes(id uint64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return idBytes
+}
+
+func proposalKey(id uint64) []byte {
+	return util.PrefixKey(proposalPrefix, proposalIDBytes(id))
+}
+
+func proposalVotesPrefix(id uint64) []byte {
+	return util.PrefixKey(proposalVotePrefix, proposalIDBytes(id))
+}
+
+func proposalVoteKey(id uint64, voter loom.Address) []byte {
+	return util.PrefixKey(proposalVotePrefix, proposalIDBytes(id), voter.Bytes())
+}
+
+func pendingKeyRotationKey(candidate loom.Address) []byte {
+	return util.PrefixKey(pendingKeyRotationPrefix, candidate.Bytes())
+}
+
+// Validator key records are keyed by the Tendermint address of the validator key.
+func validatorKeyRecordKey(tendermintAddress loom.LocalAddress) []byte {
+	return util.PrefixKey(validatorKeyRecordPrefix, tendermintAddress)
+}
+
+func delegatorRewardHistoryKey(delegator loom.Address, electionTime int64, validator loom.Address) []byte {
+	return util.PrefixKey(
+		delegatorRewardHistoryPrefix, delegator.Bytes(), historyTimeBytes(electionTime), validator.Bytes(),
+	)
+}
+
+func validatorRewardHistoryKey(validator loom.Address, electionTime int64) []byte {
+	return util.PrefixKey(validatorRewardHistoryPrefix, validator.Bytes(), historyTimeBytes(electionTime))
+}
+
+func delegationHistoryKey(
+	delegator loom.Address, electionTime int64, validator loom.Address, index uint64,
+) []byte {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+	return util.PrefixKey(
+		delegationHistoryPrefix, delegator.Bytes(), historyTimeBytes(electionTime), validator.Bytes(), indexBytes,
+	)
+}
+
+func historyElectionKey(seq uint64) []byte {
+	seqBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqBytes, seq)
+	return util.PrefixKey(historyElectionPrefix, seqBytes)
+}
+
+// The history index maps each election to the keys of the history entries recorded in it.
+func historyIndexKey(electionTime int64, entryKey []byte) []byte {
+	return util.PrefixKey(historyIndexPrefix, historyTimeBytes(electionTime), entryKey)
+}
+
+// Election times are encoded in big-endian so that history entries are sorted chronologically.
+func historyTimeBytes(electionTime int64) []byte {
+	timeBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeBytes, uint64(electionTime))
+	return timeBytes
+}
+
 func sortValidators(validators []*Validator) []*Validator {
 	sort.Sort(byPubkey(validators))
 	return validators
@@ -388,6 +540,8 @@ func updateCandidateList(ctx contract.Context) error {
 		return err
 	}
 
+	gradualFeeChangesEnabled := ctx.FeatureEnabled(features.DPOSVersion3_14, false)
+
 	// Update each candidate's fee
 	var deleteList []loom.Address
 	candidateUpdated := false
@@ -396,9 +550,15 @@ func updateCandidateList(ctx contract.Context) error {
 			c.State = CHANGING_FEE
 			candidateUpdated = true
 		} else if c.State == CHANGING_FEE {
+			oldFee := c.Fee
 			c.Fee = c.NewFee
 			c.State = REGISTERED
 			candidateUpdated = true
+			if gradualFeeChangesEnabled {
+				if err := applyPendingFeeChange(ctx, c, oldFee); err != nil {
+					return err
+				}
+			}
 		} else if c.State == UNREGISTERING {
 			deleteList = append(deleteList, loom.UnmarshalAddressPB(c.Address))
 			candidateUpdated = true
@@ -407,7 +567,16 @@ func updateCandidateList(ctx contract.Context) error {
 
 	// Remove unregistering candidates from candidates array
 	for _, candidateAddress := range deleteList {
+		if gradualFeeChangesEnabled {
+			if err := recordCandidateLastFee(ctx, candidates.Get(candidateAddress)); err != nil {
+				return err
+			}
+			deletePendingFeeChange(ctx, candidateAddress)
+		}
 		candidates.Delete(candidateAddress)
+		if ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+			deletePendingKeyRotation(ctx, candidateAddress)
+		}
 	}
 
 	// Only save CandidateList when it gets updated
@@ -508,6 +677,17 @@ func GetLocalCandidateAddressFromTendermintAddress(
 		}
 	}
 
+	// The validator may have signed with a key that was rotated out in the last election
+	if ctx.FeatureEnabled(features.DPOSVersion3_18, false) {
+		record, err := loadValidatorKeyRecord(ctx, tendermintAddress)
+		if err != nil {
+			return loom.Address{}, err
+		}
+		if record != nil {
+			return loom.UnmarshalAddressPB(record.Candidate), nil
+		}
+	}
+
 	return loom.Address{}, contract.ErrNotFound
 }
 
@@ -597,3 +777,388 @@ func loadRequestBatchTally(ctx contract.StaticContext) (*RequestBatchTally, erro
 func saveRequestBatchTally(ctx contract.Context, tally *RequestBatchTally) error {
 	return ctx.Set(requestBatchTallyKey, tally)
 }
+
+// AUTO-COMPOUNDING
+
+func isAutoCompoundEnabled(ctx contract.StaticContext, validator, delegator loom.Address) bool {
+	return ctx.Has(autoCompoundKey(validator, delegator))
+}
+
+func setAutoCompound(ctx contract.Context, validator, delegator loom.Address, enabled bool) error {
+	key := autoCompoundKey(validator, delegator)
+	if !enabled {
+		ctx.Delete(key)
+		return nil
+	}
+	return ctx.Set(key, &AutoCompoundSetting{
+		Validator: validator.MarshalPB(),
+		Delegator: delegator.MarshalPB(),
+	})
+}
+
+// BYZANTINE EVIDENCE
+
+func hasByzantineEvidence(ctx contract.StaticContext, validator loom.Address, height int64) bool {
+	return ctx.Has(byzantineEvidenceKey(validator, height))
+}
+
+func setByzantineEvidence(ctx contract.Context, validator loom.Address, height int64) error {
+	return ctx.Set(byzantineEvidenceKey(validator, height), &ByzantineEvidence{
+		Validator: validator.MarshalPB(),
+		Height:    height,
+	})
+}
+
+// CANDIDATE FEE LIMITS
+
+// Returns nil if the candidate hasn't set any fee limits.
+func loadCandidateFeeLimits(ctx contract.StaticContext, candidate loom.Address) (*CandidateFeeLimits, error) {
+	var limits CandidateFeeLimits
+	err := ctx.Get(candidateFeeLimitsKey(candidate), &limits)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func saveCandidateFeeLimits(ctx contract.Context, limits *CandidateFeeLimits) error {
+	return ctx.Set(candidateFeeLimitsKey(loom.UnmarshalAddressPB(limits.Candidate)), limits)
+}
+
+// Returns nil if the candidate doesn't have a pending fee change.
+func loadPendingFeeChange(ctx contract.StaticContext, candidate loom.Address) (*PendingFeeChange, error) {
+	var change PendingFeeChange
+	err := ctx.Get(pendingFeeChangeKey(candidate), &change)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+func savePendingFeeChange(ctx contract.Context, change *PendingFeeChange) error {
+	return ctx.Set(pendingFeeChangeKey(loom.UnmarshalAddressPB(change.Candidate)), change)
+}
+
+func deletePendingFeeChange(ctx contract.Context, candidate loom.Address) {
+	ctx.Delete(pendingFeeChangeKey(candidate))
+}
+
+// Returns the protocol-wide max fee change per election, or the default if it hasn't been changed.
+func loadMaxFeeChangePerElection(ctx contract.StaticContext) (uint64, error) {
+	var params FeeChangeParams
+	err := ctx.Get(feeChangeParamsKey, &params)
+	if err == contract.ErrNotFound {
+		return DefaultMaxFeeChangePerElection, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return params.MaxFeeChangePerElection, nil
+}
+
+func saveMaxFeeChangePerElection(ctx contract.Context, maxFeeChange uint64) error {
+	return ctx.Set(feeChangeParamsKey, &FeeChangeParams{MaxFeeChangePerElection: maxFeeChange})
+}
+
+// REDELEGATION LIMITS
+
+// Returns nil if the oracle hasn't set any redelegation limits.
+func loadRedelegationLimits(ctx contract.StaticContext) (*RedelegationLimits, error) {
+	var limits RedelegationLimits
+	err := ctx.Get(redelegationLimitsKey, &limits)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func saveRedelegationLimits(ctx contract.Context, limits *RedelegationLimits) error {
+	return ctx.Set(redelegationLimitsKey, limits)
+}
+
+// Adds a redelegation to the end of the queue.
+func enqueueRedelegation(ctx contract.Context, r *QueuedRedelegation) error {
+	var state RedelegationQueueState
+	if err := ctx.Get(redelegationQueueStateKey, &state); err != nil && err != contract.ErrNotFound {
+		return err
+	}
+	r.Seq = state.NextSeq
+	state.NextSeq++
+	if err := ctx.Set(redelegationQueueStateKey, &state); err != nil {
+		return err
+	}
+	if err := ctx.Set(
+		redelegationQueueRefKey(
+			loom.UnmarshalAddressPB(r.FormerValidator), loom.UnmarshalAddressPB(r.Delegator), r.Index,
+		),
+		&QueuedRedelegationRef{Seq: r.Seq},
+	); err != nil {
+		return err
+	}
+	return ctx.Set(redelegationQueueKey(r.Seq), r)
+}
+
+// Updates a redelegation that's already in the queue.
+func updateQueuedRedelegation(ctx contract.Context, r *QueuedRedelegation) error {
+	return ctx.Set(redelegationQueueKey(r.Seq), r)
+}
+
+func dequeueRedelegation(ctx contract.Context, r *QueuedRedelegation) {
+	ctx.Delete(redelegationQueueKey(r.Seq))
+	ctx.Delete(redelegationQueueRefKey(
+		loom.UnmarshalAddressPB(r.FormerValidator), loom.UnmarshalAddressPB(r.Delegator), r.Index,
+	))
+}
+
+// Returns true if the given delegation already has a redelegation in the queue.
+func hasQueuedRedelegation(ctx contract.StaticContext, delegation *Delegation) bool {
+	return ctx.Has(redelegationQueueRefKey(
+		loom.UnmarshalAddressPB(delegation.Validator), loom.UnmarshalAddressPB(delegation.Delegator),
+		delegation.Index,
+	))
+}
+
+// Returns nil if no redelegation has been requested for the delegation.
+func loadRedelegationCooldown(ctx contract.StaticContext, delegation *Delegation) (*RedelegationCooldown, error) {
+	var cooldown RedelegationCooldown
+	err := ctx.Get(
+		redelegationCooldownKey(
+			loom.UnmarshalAddressPB(delegation.Validator), loom.UnmarshalAddressPB(delegation.Delegator),
+			delegation.Index,
+		),
+		&cooldown,
+	)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &cooldown, nil
+}
+
+// Should be called whenever a delegation is deleted, since delegation indices are reused a stale
+// cooldown would otherwise apply to the next delegation created with the same index.
+func deleteRedelegationCooldown(ctx contract.Context, delegation *Delegation) {
+	ctx.Delete(redelegationCooldownKey(
+		loom.UnmarshalAddressPB(delegation.Validator), loom.UnmarshalAddressPB(delegation.Delegator),
+		delegation.Index,
+	))
+}
+
+func saveRedelegationCooldown(ctx contract.Context, cooldown *RedelegationCooldown) error {
+	return ctx.Set(
+		redelegationCooldownKey(
+			loom.UnmarshalAddressPB(cooldown.Validator), loom.UnmarshalAddressPB(cooldown.Delegator),
+			cooldown.Index,
+		),
+		cooldown,
+	)
+}
+
+// GOVERNANCE
+
+func loadGovernanceState(ctx contract.StaticContext) (*GovernanceState, error) {
+	var state GovernanceState
+	err := ctx.Get(governanceStateKey, &state)
+	if err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+	if state.NextProposalId == 0 {
+		state.NextProposalId = 1
+	}
+	return &state, nil
+}
+
+func saveGovernanceState(ctx contract.Context, state *GovernanceState) error {
+	return ctx.Set(governanceStateKey, state)
+}
+
+// Returns nil if the proposal doesn't exist.
+func loadProposal(ctx contract.StaticContext, id uint64) (*ParamChangeProposal, error) {
+	var proposal ParamChangeProposal
+	err := ctx.Get(proposalKey(id), &proposal)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+func saveProposal(ctx contract.Context, proposal *ParamChangeProposal) error {
+	return ctx.Set(proposalKey(proposal.Id), proposal)
+}
+
+func saveProposalVote(ctx contract.Context, vote *ProposalVote) error {
+	return ctx.Set(proposalVoteKey(vote.ProposalId, loom.UnmarshalAddressPB(vote.Voter)), vote)
+}
+
+// VALIDATOR KEY ROTATION
+
+// Returns nil if the candidate hasn't requested a key rotation.
+func loadPendingKeyRotation(ctx contract.StaticContext, candidate loom.Address) (*PendingKeyRotation, error) {
+	var rotation PendingKeyRotation
+	err := ctx.Get(pendingKeyRotationKey(candidate), &rotation)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &rotation, nil
+}
+
+func savePendingKeyRotation(ctx contract.Context, rotation *PendingKeyRotation) error {
+	return ctx.Set(pendingKeyRotationKey(loom.UnmarshalAddressPB(rotation.Candidate)), rotation)
+}
+
+func deletePendingKeyRotation(ctx contract.Context, candidate loom.Address) {
+	ctx.Delete(pendingKeyRotationKey(candidate))
+}
+
+// Returns nil if the key has never been used by a candidate that rotated its key.
+func loadValidatorKeyRecord(ctx contract.StaticContext, tendermintAddress loom.LocalAddress) (*ValidatorKeyRecord, error) {
+	var record ValidatorKeyRecord
+	err := ctx.Get(validatorKeyRecordKey(tendermintAddress), &record)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func saveValidatorKeyRecord(ctx contract.Context, record *ValidatorKeyRecord) error {
+	return ctx.Set(validatorKeyRecordKey(loom.LocalAddressFromPublicKeyV2(record.PubKey)), record)
+}
+
+// LIQUID STAKING
+
+func loadLiquidStakePool(ctx contract.StaticContext, validator loom.Address) (*LiquidStakePool, error) {
+	var pool LiquidStakePool
+	err := ctx.Get(liquidStakePoolKey(validator), &pool)
+	if err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+	if pool.Validator == nil {
+		pool.Validator = validator.MarshalPB()
+	}
+	if pool.TotalShares == nil {
+		pool.TotalShares = loom.BigZeroPB()
+	}
+	if pool.Unbonded == nil {
+		pool.Unbonded = loom.BigZeroPB()
+	}
+	if pool.Redeemed == nil {
+		pool.Redeemed = loom.BigZeroPB()
+	}
+	return &pool, nil
+}
+
+func saveLiquidStakePool(ctx contract.Context, pool *LiquidStakePool) error {
+	return ctx.Set(liquidStakePoolKey(loom.UnmarshalAddressPB(pool.Validator)), pool)
+}
+
+func loadLiquidStakeBalance(ctx contract.StaticContext, validator, owner loom.Address) (*LiquidStakeBalance, error) {
+	var balance LiquidStakeBalance
+	err := ctx.Get(liquidStakeBalanceKey(validator, owner), &balance)
+	if err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+	if balance.Validator == nil {
+		balance.Validator = validator.MarshalPB()
+	}
+	if balance.Owner == nil {
+		balance.Owner = owner.MarshalPB()
+	}
+	if balance.Shares == nil {
+		balance.Shares = loom.BigZeroPB()
+	}
+	return &balance, nil
+}
+
+func saveLiquidStakeBalance(ctx contract.Context, balance *LiquidStakeBalance) error {
+	key := liquidStakeBalanceKey(
+		loom.UnmarshalAddressPB(balance.Validator), loom.UnmarshalAddressPB(balance.Owner),
+	)
+	if common.IsZero(balance.Shares.Value) {
+		ctx.Delete(key)
+		return nil
+	}
+	return ctx.Set(key, balance)
+}
+
+func loadLiquidStakeAllowance(
+	ctx contract.StaticContext, validator, owner, spender loom.Address,
+) (*LiquidStakeAllowance, error) {
+	var allowance LiquidStakeAllowance
+	err := ctx.Get(liquidStakeAllowanceKey(validator, owner, spender), &allowance)
+	if err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+	if allowance.Validator == nil {
+		allowance.Validator = validator.MarshalPB()
+		allowance.Owner = owner.MarshalPB()
+		allowance.Spender = spender.MarshalPB()
+	}
+	if allowance.Shares == nil {
+		allowance.Shares = loom.BigZeroPB()
+	}
+	return &allowance, nil
+}
+
+func saveLiquidStakeAllowance(ctx contract.Context, allowance *LiquidStakeAllowance) error {
+	key := liquidStakeAllowanceKey(
+		loom.UnmarshalAddressPB(allowance.Validator),
+		loom.UnmarshalAddressPB(allowance.Owner),
+		loom.UnmarshalAddressPB(allowance.Spender),
+	)
+	if common.IsZero(allowance.Shares.Value) {
+		ctx.Delete(key)
+		return nil
+	}
+	return ctx.Set(key, allowance)
+}
+
+func loadLiquidRedemptionList(ctx contract.StaticContext) (*LiquidRedemptionList, error) {
+	var list LiquidRedemptionList
+	err := ctx.Get(liquidRedemptionsKey, &list)
+	if err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func saveLiquidRedemptionList(ctx contract.Context, list *LiquidRedemptionList) error {
+	if len(list.Redemptions) == 0 {
+		ctx.Delete(liquidRedemptionsKey)
+		return nil
+	}
+	return ctx.Set(liquidRedemptionsKey, list)
+}
+
+// Adds a redemption to the queue, or increases the amount of the queued redemption for the same
+// owner & validator.
+func queueLiquidRedemption(ctx contract.Context, redemption *LiquidRedemption) error {
+	list, err := loadLiquidRedemptionList(ctx)
+	if err != nil {
+		return err
+	}
+
+	owner := loom.UnmarshalAddressPB(redemption.Owner)
+	validator := loom.UnmarshalAddressPB(redemption.Validator)
+	for _, r := range list.Redemptions {
+		if loom.UnmarshalAddressPB(r.Owner).Compare(owner) == 0 &&
+			loom.UnmarshalAddressPB(r.Validator).Compare(validator) == 0 {
+			r.Amount.Value.Add(&r.Amount.Value, &redemption.Amount.Value)
+			return saveLiquidRedemptionList(ctx, list)
+		}
+	}
+
+	list.Redemptions = append(list.Redemptions, redemption)
+	return saveLiquidRedemptionList(ctx, list)
+}
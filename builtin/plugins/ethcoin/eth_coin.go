@@ -9,6 +9,7 @@ import (
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/types"
 	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/pkg/errors"
 )
@@ -38,6 +39,20 @@ type (
 	Allowance            = ctypes.Allowance
 	Account              = ctypes.Account
 	Economy              = ctypes.Economy
+
+	PermitRequest                 = coin.PermitRequest
+	PermitNonceRequest            = coin.PermitNonceRequest
+	PermitNonceResponse           = coin.PermitNonceResponse
+	TransferFromWithPermitRequest = coin.TransferFromWithPermitRequest
+
+	ListHoldersRequest      = coin.ListHoldersRequest
+	ListHoldersResponse     = coin.ListHoldersResponse
+	TopHoldersRequest       = coin.TopHoldersRequest
+	TopHoldersResponse      = coin.TopHoldersResponse
+	TransferHistoryRequest  = coin.TransferHistoryRequest
+	TransferHistoryResponse = coin.TransferHistoryResponse
+
+	BatchTransferRequest = coin.BatchTransferRequest
 )
 
 var (
@@ -89,8 +104,7 @@ func (c *ETHCoin) Init(ctx contract.Context, req *InitRequest) error {
 				Value: *balance,
 			},
 		}
-		err := ctx.Set(accountKey(owner), acct)
-		if err != nil {
+		if err := saveAccount(ctx, acct); err != nil {
 			return err
 		}
 
@@ -155,6 +169,9 @@ func Mint(ctx contract.Context, to loom.Address, amount *loom.BigUInt) error {
 	if err := emitTransferEvent(ctx, mintAddress, to, amount); err != nil {
 		return err
 	}
+	if err := coin.RecordTransfer(ctx, mintAddress, to, amount); err != nil {
+		return err
+	}
 
 	return ctx.Set(economyKey, econ)
 }
@@ -250,6 +267,22 @@ func (c *ETHCoin) Transfer(ctx contract.Context, req *TransferRequest) error {
 	return Transfer(ctx, from, to, &amount)
 }
 
+// BatchTransfer transfers ETH from the caller to multiple recipients, either all the transfers
+// succeed or none of them do.
+func (c *ETHCoin) BatchTransfer(ctx contract.Context, req *BatchTransferRequest) error {
+	if err := coin.ValidateBatchTransfer(ctx, req); err != nil {
+		return err
+	}
+	from := ctx.Message().Sender
+	for _, entry := range req.Transfers {
+		amount := entry.Amount.Value
+		if err := transfer(ctx, from, loom.UnmarshalAddressPB(entry.To), &amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Transfer is used by the AccountBalanceManager to allow transfer of ETH within the EVM.
 func Transfer(ctx contract.Context, from, to loom.Address, amount *loom.BigUInt) error {
 	if ctx.FeatureEnabled(features.CoinVersion1_1Feature, false) {
@@ -293,7 +326,10 @@ func transfer(ctx contract.Context, from, to loom.Address, amount *loom.BigUInt)
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, amount)
+	if err := emitTransferEvent(ctx, from, to, amount); err != nil {
+		return err
+	}
+	return coin.RecordTransfer(ctx, from, to, amount)
 }
 
 func (c *ETHCoin) Approve(ctx contract.Context, req *ApproveRequest) error {
@@ -339,6 +375,51 @@ func (c *ETHCoin) Allowance(ctx contract.StaticContext, req *AllowanceRequest) (
 	}, nil
 }
 
+// Permit sets the allowance of the spender to the amount specified in a permit signed by the owner,
+// see the Coin contract for details.
+func (c *ETHCoin) Permit(ctx contract.Context, req *PermitRequest) error {
+	return coin.ApplyPermit(ctx, req, ApprovalEventTopic)
+}
+
+// TransferFromWithPermit sets the allowance of the caller to the amount specified in a permit
+// signed by the owner, and then transfers ETH from the owner's account to the given account.
+func (c *ETHCoin) TransferFromWithPermit(ctx contract.Context, req *TransferFromWithPermitRequest) error {
+	transferReq, err := coin.ApplyTransferPermit(ctx, req, ApprovalEventTopic)
+	if err != nil {
+		return err
+	}
+	return c.TransferFrom(ctx, transferReq)
+}
+
+// PermitNonce returns the nonce that must be included in the next permit signed by the owner.
+func (c *ETHCoin) PermitNonce(ctx contract.StaticContext, req *PermitNonceRequest) (*PermitNonceResponse, error) {
+	if req.Owner == nil {
+		return nil, ErrInvalidRequest
+	}
+	nonce, err := coin.GetPermitNonce(ctx, loom.UnmarshalAddressPB(req.Owner))
+	if err != nil {
+		return nil, err
+	}
+	return &PermitNonceResponse{Nonce: nonce}, nil
+}
+
+// ListHolders returns a page of accounts with a non-zero balance, see coin.GetHolderPage.
+func (c *ETHCoin) ListHolders(ctx contract.StaticContext, req *ListHoldersRequest) (*ListHoldersResponse, error) {
+	return coin.GetHolderPage(ctx, req)
+}
+
+// TopHolders returns a page of accounts with a non-zero balance, ordered by balance (highest first).
+func (c *ETHCoin) TopHolders(ctx contract.StaticContext, req *TopHoldersRequest) (*TopHoldersResponse, error) {
+	return coin.GetTopHolders(ctx, req)
+}
+
+// TransferHistory returns a page of transfers to & from an account, newest first.
+func (c *ETHCoin) TransferHistory(
+	ctx contract.StaticContext, req *TransferHistoryRequest,
+) (*TransferHistoryResponse, error) {
+	return coin.GetTransferHistory(ctx, req)
+}
+
 func (c *ETHCoin) TransferFrom(ctx contract.Context, req *TransferFromRequest) error {
 	if ctx.FeatureEnabled(features.CoinVersion1_2Feature, false) &&
 		(req.Amount == nil || req.From == nil || req.To == nil) {
@@ -407,7 +488,10 @@ func (c *ETHCoin) transferFrom(ctx contract.Context, req *TransferFromRequest) e
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, &amount)
+	if err := emitTransferEvent(ctx, from, to, &amount); err != nil {
+		return err
+	}
+	return coin.RecordTransfer(ctx, from, to, &amount)
 }
 
 func loadAccount(ctx contract.StaticContext, owner loom.Address) (*Account, error) {
@@ -427,7 +511,10 @@ func loadAccount(ctx contract.StaticContext, owner loom.Address) (*Account, erro
 
 func saveAccount(ctx contract.Context, acct *Account) error {
 	owner := loom.UnmarshalAddressPB(acct.Owner)
-	return ctx.Set(accountKey(owner), acct)
+	if err := ctx.Set(accountKey(owner), acct); err != nil {
+		return err
+	}
+	return coin.UpdateHolderIndex(ctx, acct)
 }
 
 func loadAllowance(ctx contract.StaticContext, owner, spender loom.Address) (*Allowance, error) {
@@ -488,7 +575,10 @@ func legacyTransfer(ctx contract.Context, from, to loom.Address, amount *loom.Bi
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, amount)
+	if err := emitTransferEvent(ctx, from, to, amount); err != nil {
+		return err
+	}
+	return coin.RecordTransfer(ctx, from, to, amount)
 }
 
 func (c *ETHCoin) legacyTransferFrom(ctx contract.Context, req *TransferFromRequest) error {
@@ -545,7 +635,10 @@ func (c *ETHCoin) legacyTransferFrom(ctx contract.Context, req *TransferFromRequ
 		return err
 	}
 
-	return emitTransferEvent(ctx, from, to, &amount)
+	if err := emitTransferEvent(ctx, from, to, &amount); err != nil {
+		return err
+	}
+	return coin.RecordTransfer(ctx, from, to, &amount)
 }
 
 // Events
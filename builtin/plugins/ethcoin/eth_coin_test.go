@@ -2,14 +2,18 @@ package ethcoin
 
 import (
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common/evmcompat"
 	"github.com/loomnetwork/go-loom/plugin"
 	"github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
 	"github.com/loomnetwork/loomchain/features"
 )
 
@@ -242,6 +246,65 @@ func TestTransferFrom(t *testing.T) {
 	assert.Equal(t, ErrInvalidRequest, err)
 }
 
+func TestTransferFromWithPermit(t *testing.T) {
+	contract := &ETHCoin{}
+	now := time.Now()
+	pctx := plugin.CreateFakeContext(addr3, addr2).
+		WithBlock(loom.BlockHeader{ChainID: "chain", Time: now.Unix()})
+	pctx.SetFeature(features.CoinVersion1_1Feature, true)
+	pctx.SetFeature(features.CoinVersion1_4Feature, true)
+	ctx := contractpb.WrapPluginContext(pctx)
+
+	ownerKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ownerLocal, err := loom.LocalAddressFromHexString(crypto.PubkeyToAddress(ownerKey.PublicKey).Hex())
+	require.NoError(t, err)
+	owner := loom.Address{ChainID: "eth", Local: ownerLocal}
+	require.NoError(t, saveAccount(ctx, &Account{
+		Owner:   owner.MarshalPB(),
+		Balance: &types.BigUInt{Value: *loom.NewBigUIntFromInt(100)},
+	}))
+
+	amount := loom.NewBigUIntFromInt(40)
+	deadline := uint64(now.Add(time.Hour).Unix())
+	sig, err := coin.SignPermit(
+		"chain", addr2, owner, addr3, amount, 0, deadline, ownerKey, evmcompat.SignatureType_EIP712,
+	)
+	require.NoError(t, err)
+	req := &TransferFromWithPermitRequest{
+		Permit: &PermitRequest{
+			Owner:     owner.MarshalPB(),
+			Spender:   addr3.MarshalPB(),
+			Amount:    &types.BigUInt{Value: *amount},
+			Nonce:     0,
+			Deadline:  deadline,
+			Signature: sig,
+		},
+		To:     addr1.MarshalPB(),
+		Amount: &types.BigUInt{Value: *loom.NewBigUIntFromInt(30)},
+	}
+
+	// only the spender can use the permit
+	require.Error(t, contract.TransferFromWithPermit(contractpb.WrapPluginContext(pctx.WithSender(addr1)), req))
+
+	require.NoError(t, contract.TransferFromWithPermit(ctx, req))
+	balResp, err := contract.BalanceOf(ctx, &BalanceOfRequest{Owner: addr1.MarshalPB()})
+	require.NoError(t, err)
+	assert.Equal(t, 30, int(balResp.Balance.Value.Int64()))
+	balResp, err = contract.BalanceOf(ctx, &BalanceOfRequest{Owner: owner.MarshalPB()})
+	require.NoError(t, err)
+	assert.Equal(t, 70, int(balResp.Balance.Value.Int64()))
+	allowResp, err := contract.Allowance(ctx, &AllowanceRequest{
+		Owner:   owner.MarshalPB(),
+		Spender: addr3.MarshalPB(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10, int(allowResp.Amount.Value.Int64()))
+
+	// a permit can only be used once
+	require.Equal(t, coin.ErrInvalidPermitNonce, contract.TransferFromWithPermit(ctx, req))
+}
+
 // Verify ETHCoin.TransferFrom works correctly when the to & from addresses are the same.
 func TestTransferFromSelf(t *testing.T) {
 	pctx := plugin.CreateFakeContext(addr1, addr1)
@@ -20,6 +20,9 @@ type (
 	SetValidatorInfo         = cctypes.SetValidatorInfoRequest
 	GetValidatorInfoRequest  = cctypes.GetValidatorInfoRequest
 	GetValidatorInfoResponse = cctypes.GetValidatorInfoResponse
+	GetParamsRequest         = cctypes.GetParamsRequest
+	GetParamsResponse        = cctypes.GetParamsResponse
+	Params                   = cctypes.Params
 )
 
 const (
@@ -35,6 +38,17 @@ const (
 	FeatureDisabled = cctypes.Feature_DISABLED
 )
 
+// ScheduledUpgrade describes the block height at which the chain will activate one or more
+// features that require a newer build than the one the node is currently running.
+type ScheduledUpgrade struct {
+	// Height of the first block at which the unsupported features will be activated
+	Height uint64
+	// Minimum build number required to process blocks at (and beyond) Height
+	BuildNumber uint64
+	// Names of the features that will be activated at Height
+	Features []string
+}
+
 // ChainConfigClient is used to enable pending features in the ChainConfig contract.
 type ChainConfigClient struct {
 	Address  goloom.Address
@@ -145,3 +159,64 @@ func (cc *ChainConfigClient) GetValidatorInfo() (*GetValidatorInfoResponse, erro
 	}
 	return &resp, nil
 }
+
+// GetScheduledUpgrade returns the earliest upgrade the node must perform in order to keep processing
+// blocks with the given build, or nil if the build supports all the features that are about to be
+// activated on the chain.
+func (cc *ChainConfigClient) GetScheduledUpgrade(buildNumber uint64) (*ScheduledUpgrade, error) {
+	var featuresResp ListFeaturesResponse
+	if _, err := cc.contract.StaticCall(
+		"ListFeatures",
+		&ListFeaturesRequest{},
+		cc.caller,
+		&featuresResp,
+	); err != nil {
+		cc.logger.Error("Failed to retrieve features from ChainConfig contract", "err", err)
+		return nil, err
+	}
+
+	var paramsResp GetParamsResponse
+	if _, err := cc.contract.StaticCall(
+		"GetParams",
+		&GetParamsRequest{},
+		cc.caller,
+		&paramsResp,
+	); err != nil {
+		cc.logger.Error("Failed to retrieve params from ChainConfig contract", "err", err)
+		return nil, err
+	}
+
+	return findScheduledUpgrade(featuresResp.Features, paramsResp.Params, buildNumber), nil
+}
+
+// findScheduledUpgrade looks for WAITING features that require a newer build than the given one.
+// The ChainConfig contract activates a WAITING feature at the first block whose height exceeds the
+// height at which the feature started waiting by the configured number of block confirmations.
+func findScheduledUpgrade(features []*Feature, params *Params, buildNumber uint64) *ScheduledUpgrade {
+	var numBlockConfirmations uint64
+	if params != nil {
+		numBlockConfirmations = params.NumBlockConfirmations
+	}
+
+	var upgrade *ScheduledUpgrade
+	for _, feature := range features {
+		if feature.Status != FeatureWaiting || feature.BuildNumber <= buildNumber {
+			continue
+		}
+		height := feature.BlockHeight + numBlockConfirmations + 1
+		switch {
+		case upgrade == nil || height < upgrade.Height:
+			upgrade = &ScheduledUpgrade{
+				Height:      height,
+				BuildNumber: feature.BuildNumber,
+				Features:    []string{feature.Name},
+			}
+		case height == upgrade.Height:
+			upgrade.Features = append(upgrade.Features, feature.Name)
+			if feature.BuildNumber > upgrade.BuildNumber {
+				upgrade.BuildNumber = feature.BuildNumber
+			}
+		}
+	}
+	return upgrade
+}
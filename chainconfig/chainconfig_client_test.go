@@ -0,0 +1,40 @@
+package chainconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindScheduledUpgrade(t *testing.T) {
+	params := &Params{VoteThreshold: 67, NumBlockConfirmations: 10}
+	features := []*Feature{
+		{Name: "feature-a", Status: FeatureEnabled, BuildNumber: 1200},
+		{Name: "feature-b", Status: FeaturePending, BuildNumber: 1300},
+		{Name: "feature-c", Status: FeatureWaiting, BuildNumber: 1000, BlockHeight: 50},
+		{Name: "feature-d", Status: FeatureWaiting, BuildNumber: 1100, BlockHeight: 200},
+	}
+
+	// build supports all the waiting features
+	require.Nil(t, findScheduledUpgrade(features, params, 1100))
+
+	// build doesn't support one of the waiting features
+	upgrade := findScheduledUpgrade(features, params, 1000)
+	require.NotNil(t, upgrade)
+	require.Equal(t, uint64(211), upgrade.Height)
+	require.Equal(t, uint64(1100), upgrade.BuildNumber)
+	require.Equal(t, []string{"feature-d"}, upgrade.Features)
+
+	// the earliest activation height wins, features activated at the same height are merged
+	features = append(features, &Feature{
+		Name: "feature-e", Status: FeatureWaiting, BuildNumber: 1050, BlockHeight: 50,
+	})
+	features = append(features, &Feature{
+		Name: "feature-f", Status: FeatureWaiting, BuildNumber: 1150, BlockHeight: 50,
+	})
+	upgrade = findScheduledUpgrade(features, params, 1000)
+	require.NotNil(t, upgrade)
+	require.Equal(t, uint64(61), upgrade.Height)
+	require.Equal(t, uint64(1150), upgrade.BuildNumber)
+	require.Equal(t, []string{"feature-e", "feature-f"}, upgrade.Features)
+}
@@ -1,20 +1,63 @@
 package chainconfig
 
 import (
+	"os"
 	"runtime"
 	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	goloom "github.com/loomnetwork/go-loom"
 	"github.com/loomnetwork/go-loom/auth"
 	"github.com/loomnetwork/go-loom/client"
 	"github.com/loomnetwork/loomchain"
 	"github.com/loomnetwork/loomchain/abci/backend"
 	"github.com/loomnetwork/loomchain/config"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
 )
 
+// UpgradeExitCode is the exit code used by the node when it halts for an upgrade and there's no
+// upgraded binary to switch to, process supervisors can use it to tell an upgrade halt apart
+// from a crash.
+const UpgradeExitCode = 3
+
+var (
+	scheduledUpgradeHeight metrics.Gauge
+	requiredBuildNumber    metrics.Gauge
+)
+
+func init() {
+	scheduledUpgradeHeight = kitprometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Namespace: "loomchain",
+		Subsystem: "chainconfig",
+		Name:      "scheduled_upgrade_height",
+		Help:      "Block height at which the node must be running a newer build (zero if no upgrade is scheduled).",
+	}, nil)
+	requiredBuildNumber = kitprometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Namespace: "loomchain",
+		Subsystem: "chainconfig",
+		Name:      "required_build_number",
+		Help:      "Build number the node must be running by the scheduled upgrade height (zero if no upgrade is scheduled).",
+	}, nil)
+}
+
+// UpgradeHaltScheduler is implemented by the app, it's used to halt the node once the last block
+// the current build can process has been committed.
+type UpgradeHaltScheduler interface {
+	// LastCommittedHeight returns the height of the last committed block.
+	LastCommittedHeight() int64
+	// ScheduleHalt arranges for onHalt to be called once the block at the given height is committed.
+	ScheduleHalt(height int64, onHalt func(height int64))
+	// CancelHalt cancels a previously scheduled halt.
+	CancelHalt()
+}
+
 // ChainConfigRoutine periodically checks for pending features in the ChainConfig contract and
-// automatically votes to enable those features.
+// automatically votes to enable those features. It also keeps track of upcoming feature activations
+// that aren't supported by the current build, and halts the node before they take effect.
 type ChainConfigRoutine struct {
 	cfg         *config.ChainConfigConfig
 	chainID     string
@@ -23,6 +66,12 @@ type ChainConfigRoutine struct {
 	logger      *goloom.Logger
 	buildNumber uint64
 	node        backend.Backend
+	app         UpgradeHaltScheduler
+	// stops the node & closes its stores, called before the process exits for an upgrade
+	shutdown func()
+	// height of the last block before the upgrade the node is scheduled to halt at, zero if no halt
+	// is scheduled, only accessed by the upgrade watcher goroutine
+	haltHeight int64
 }
 
 // NewChainConfigRoutine returns a new instance of ChainConfigRoutine
@@ -31,6 +80,8 @@ func NewChainConfigRoutine(
 	chainID string,
 	nodeSigner auth.Signer,
 	node backend.Backend,
+	app UpgradeHaltScheduler,
+	shutdown func(),
 	logger *goloom.Logger,
 ) (*ChainConfigRoutine, error) {
 	address := goloom.Address{
@@ -49,6 +100,8 @@ func NewChainConfigRoutine(
 		logger:      logger,
 		buildNumber: build,
 		node:        node,
+		app:         app,
+		shutdown:    shutdown,
 	}, nil
 }
 
@@ -110,3 +163,98 @@ func (cc *ChainConfigRoutine) run() {
 		time.Sleep(time.Duration(cc.cfg.EnableFeatureInterval) * time.Second)
 	}
 }
+
+// RunUpgradeWatcherWithRecovery should be run as a go-routine, it will auto-restart on panic unless
+// it hits a runtime error.
+func (cc *ChainConfigRoutine) RunUpgradeWatcherWithRecovery() {
+	defer func() {
+		if r := recover(); r != nil {
+			cc.logger.Error("recovered from panic in ChainConfigRoutine upgrade watcher", "r", r)
+			// Unless it's a runtime error restart the goroutine
+			if _, ok := r.(runtime.Error); !ok {
+				time.Sleep(30 * time.Second)
+				cc.logger.Info("Restarting ChainConfigRoutine upgrade watcher.")
+				go cc.RunUpgradeWatcherWithRecovery()
+			}
+		}
+	}()
+
+	cc.watchForUpgrades()
+}
+
+func (cc *ChainConfigRoutine) watchForUpgrades() {
+	for {
+		dappClient := client.NewDAppChainRPCClient(cc.chainID, cc.cfg.DAppChainWriteURI, cc.cfg.DAppChainReadURI)
+		chainConfigClient, err := NewChainConfigClient(dappClient, cc.address, cc.signer, cc.logger)
+		if err != nil {
+			cc.logger.Error("Failed to create ChainConfigClient", "err", err)
+		} else if upgrade, err := chainConfigClient.GetScheduledUpgrade(cc.buildNumber); err == nil {
+			// NOTE: errors are logged by the client, no need to log again
+			cc.checkScheduledUpgrade(upgrade)
+		}
+		time.Sleep(time.Duration(cc.cfg.UpgradeCheckInterval) * time.Second)
+	}
+}
+
+func (cc *ChainConfigRoutine) checkScheduledUpgrade(upgrade *ScheduledUpgrade) {
+	if upgrade == nil {
+		scheduledUpgradeHeight.Set(0)
+		requiredBuildNumber.Set(0)
+		if cc.haltHeight != 0 {
+			cc.logger.Info("Scheduled upgrade was cancelled, node will keep running", "halt_height", cc.haltHeight)
+			cc.app.CancelHalt()
+			cc.haltHeight = 0
+		}
+		return
+	}
+
+	scheduledUpgradeHeight.Set(float64(upgrade.Height))
+	requiredBuildNumber.Set(float64(upgrade.BuildNumber))
+
+	// The current build can still process every block before the upgrade height, so the node keeps
+	// running until the block right before the upgrade height has been committed.
+	haltHeight := int64(upgrade.Height) - 1
+	if haltHeight < 1 {
+		haltHeight = 1
+	}
+	curHeight := cc.app.LastCommittedHeight()
+	logArgs := []interface{}{
+		"upgrade_height", upgrade.Height,
+		"halt_height", haltHeight,
+		"current_height", curHeight,
+		"current_build", cc.buildNumber,
+		"required_build", upgrade.BuildNumber,
+		"features", strings.Join(upgrade.Features, ","),
+	}
+	if curHeight+cc.cfg.UpgradeHaltMargin < haltHeight {
+		cc.logger.Info("Node must be upgraded before the scheduled upgrade height", logArgs...)
+	} else {
+		cc.logger.Warn("Node will halt soon, it must be upgraded before the scheduled upgrade height", logArgs...)
+	}
+
+	if cc.haltHeight != haltHeight {
+		cc.haltHeight = haltHeight
+		cc.app.ScheduleHalt(haltHeight, func(height int64) {
+			cc.logger.Error(
+				"Halting node, current build doesn't support features scheduled for activation",
+				append(logArgs[:len(logArgs):len(logArgs)], "halted_at", height)...,
+			)
+			cc.halt()
+		})
+	}
+}
+
+// halt shuts down the node, and then replaces the current process with the upgraded binary if one
+// has been configured, otherwise it just terminates the process.
+func (cc *ChainConfigRoutine) halt() {
+	cc.shutdown()
+
+	if binPath := cc.cfg.UpgradeBinaryPath; binPath != "" {
+		cc.logger.Info("Switching to upgraded binary", "path", binPath)
+		args := append([]string{binPath}, os.Args[1:]...)
+		if err := syscall.Exec(binPath, args, os.Environ()); err != nil {
+			cc.logger.Error("Failed to execute upgraded binary", "path", binPath, "err", err)
+		}
+	}
+	os.Exit(UpgradeExitCode)
+}
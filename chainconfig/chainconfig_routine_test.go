@@ -0,0 +1,54 @@
+package chainconfig
+
+import (
+	"testing"
+
+	goloom "github.com/loomnetwork/go-loom"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loomnetwork/loomchain/config"
+)
+
+type fakeHaltScheduler struct {
+	height     int64
+	haltHeight int64
+	onHalt     func(height int64)
+}
+
+func (s *fakeHaltScheduler) LastCommittedHeight() int64 {
+	return s.height
+}
+
+func (s *fakeHaltScheduler) ScheduleHalt(height int64, onHalt func(height int64)) {
+	s.haltHeight = height
+	s.onHalt = onHalt
+}
+
+func (s *fakeHaltScheduler) CancelHalt() {
+	s.haltHeight = 0
+	s.onHalt = nil
+}
+
+func TestCheckScheduledUpgrade(t *testing.T) {
+	app := &fakeHaltScheduler{height: 50}
+	cc := &ChainConfigRoutine{
+		cfg:         config.DefaultChainConfigConfig(46658),
+		logger:      goloom.NewLoomLogger("error", ""),
+		buildNumber: 1000,
+		app:         app,
+	}
+
+	// the node should keep running until the block before the upgrade height is committed
+	cc.checkScheduledUpgrade(&ScheduledUpgrade{Height: 100, BuildNumber: 1100})
+	require.Equal(t, int64(99), app.haltHeight)
+	require.NotNil(t, app.onHalt)
+
+	// rescheduled upgrade
+	cc.checkScheduledUpgrade(&ScheduledUpgrade{Height: 120, BuildNumber: 1100})
+	require.Equal(t, int64(119), app.haltHeight)
+
+	// cancelled upgrade
+	cc.checkScheduledUpgrade(nil)
+	require.Equal(t, int64(0), app.haltHeight)
+	require.Nil(t, app.onHalt)
+}
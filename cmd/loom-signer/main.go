@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/loomnetwork/loomchain/privval"
+	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
+	remotepv "github.com/loomnetwork/loomchain/privval/remote"
+)
+
+// loom-signer is a minimal remote signer, it holds a validator key on behalf of a loom node that's
+// configured to use a remote signer. It's mainly intended for use in e2e tests, though it can also
+// be used to keep the validator key off the node host.
+
+func main() {
+	rootCmd := &cobra.Command{
+		Use:   "loom-signer",
+		Short: "Loom remote signer",
+	}
+	rootCmd.AddCommand(
+		genIdentityCmd(),
+		runCmd(),
+	)
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func genIdentityCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "gen-identity [output file]",
+		Short: "Generates a key a node or signer can authenticate itself with",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			identityKey, err := remotepv.GenIdentityKey(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Identity key saved to %s\n", args[0])
+			fmt.Printf("Public key: %s\n", remotepv.EncodePubKey(identityKey.PubKey()))
+			return nil
+		},
+	}
+}
+
+type runFlags struct {
+	ListenAddress   string
+	PrivValFile     string
+	IdentityKeyFile string
+	AuthorizedKeys  []string
+	ChainID         string
+	SafetyHeight    int64
+}
+
+func runCmd() *cobra.Command {
+	var flags runFlags
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs the signer",
+		Long: "Runs the signer, the priv validator file is generated if it doesn't exist yet. " +
+			"The priv validator file stores the last height/round/step signed by the validator key, " +
+			"so it must not be shared between multiple signers.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSigner(&flags)
+		},
+	}
+	cmd.Flags().StringVar(&flags.ListenAddress, "listen", "tcp://127.0.0.1:26659", "Address to listen on")
+	cmd.Flags().StringVar(&flags.PrivValFile, "priv-validator", "priv_validator.json", "Priv validator file")
+	cmd.Flags().StringVar(&flags.IdentityKeyFile, "identity", "signer_identity.json", "Signer identity key file")
+	cmd.Flags().StringSliceVar(
+		&flags.AuthorizedKeys, "authorized-key", nil, "Base64 encoded public key of a node allowed to connect",
+	)
+	cmd.Flags().StringVar(&flags.ChainID, "chain-id", "default", "Chain ID votes & proposals are signed for")
+	cmd.Flags().Int64Var(
+		&flags.SafetyHeight, "safety-height", 0, "Refuse to sign votes & proposals below this height",
+	)
+	return cmd
+}
+
+func runSigner(flags *runFlags) error {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+
+	if len(flags.AuthorizedKeys) == 0 {
+		return errors.New("at least one authorized key must be specified")
+	}
+	authorizedKeys := make([]crypto.PubKey, 0, len(flags.AuthorizedKeys))
+	for _, encoded := range flags.AuthorizedKeys {
+		pubKey, err := remotepv.ParsePubKey(encoded)
+		if err != nil {
+			return err
+		}
+		authorizedKeys = append(authorizedKeys, pubKey)
+	}
+
+	identityKey, err := remotepv.LoadIdentityKey(flags.IdentityKeyFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to load identity key")
+	}
+
+	hsmConfig := hsmpv.DefaultConfig()
+	var pv privval.PrivValidator
+	if cmn.FileExists(flags.PrivValFile) {
+		pv, err = privval.LoadPrivVal(flags.PrivValFile, hsmConfig, nil)
+	} else {
+		pv, err = privval.GenPrivVal(flags.PrivValFile, hsmConfig, nil)
+		if err == nil {
+			pv.Save()
+		}
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to load priv validator")
+	}
+	raised, err := privval.EnforceSafetyHeight(pv, flags.SafetyHeight)
+	if err != nil {
+		return err
+	}
+	if raised {
+		logger.Info("Raised priv validator sign state to safety height", "height", flags.SafetyHeight)
+	}
+
+	protocol, address := cmn.ProtocolAndAddress(flags.ListenAddress)
+	ln, err := net.Listen(protocol, address)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(
+		"Remote signer started",
+		"address", flags.ListenAddress,
+		"identity", remotepv.EncodePubKey(identityKey.PubKey()),
+		"validator", remotepv.EncodePubKey(pv.GetPubKey()),
+	)
+
+	go func() {
+		sigC := make(chan os.Signal, 1)
+		signal.Notify(sigC, syscall.SIGINT, syscall.SIGTERM)
+		<-sigC
+		ln.Close()
+	}()
+
+	signer := remotepv.NewSigner(pv, identityKey, authorizedKeys, flags.ChainID, logger)
+	if err := signer.Serve(ln); err != nil {
+		// Serve only returns once the listener is closed
+		logger.Info("Remote signer stopped", "err", err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ package main
 
 import (
 	"crypto/ecdsa"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -131,35 +132,105 @@ func GetMapping() *cobra.Command {
 	return cmd
 }
 
+const listMappingsCmdExample = `
+loom addressmapper list-mappings
+loom addressmapper list-mappings --foreign-chain eth --limit 500
+loom addressmapper list-mappings --cursor 0x0a0365746812144d6f4ab4a7ecb3a3e0f26d68afeb3b0dc0be2ae1
+`
+
 func ListMappingCmd() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var foreignChainID, cursor string
+	var limit uint32
+	var legacy bool
 	cmd := &cobra.Command{
-		Use:   "list-mappings",
-		Short: "list user account mappings",
-		Args:  cobra.MinimumNArgs(0),
+		Use:     "list-mappings",
+		Short:   "list user account mappings",
+		Example: listMappingsCmdExample,
+		Args:    cobra.MinimumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var resp address_mapper.ListMappingResponse
-			err := cli.StaticCallContractWithFlags(&flags, AddressMapperName, "ListMapping",
-				&address_mapper.ListMappingRequest{}, &resp)
-			if err != nil {
-				return errors.Wrap(err, "static call contract")
-			}
 			type maxLength struct {
 				From int
 				To   int
 			}
 			ml := maxLength{From: 50, To: 50}
 
-			fmt.Printf("%-*s | %-*s \n", ml.From, "From", ml.To, "To")
+			if legacy {
+				var resp address_mapper.ListMappingResponse
+				err := cli.StaticCallContractWithFlags(&flags, AddressMapperName, "ListMapping",
+					&address_mapper.ListMappingRequest{}, &resp)
+				if err != nil {
+					return errors.Wrap(err, "static call contract")
+				}
+
+				fmt.Printf("%-*s | %-*s \n", ml.From, "From", ml.To, "To")
+				for _, value := range resp.Mappings {
+					fmt.Printf("%-*s | %-*s\n",
+						ml.From, loom.UnmarshalAddressPB(value.From).String(),
+						ml.To, loom.UnmarshalAddressPB(value.To).String())
+				}
+				return nil
+			}
+
+			req := &address_mapper.ListMappingPageRequest{
+				ChainId: foreignChainID,
+				Limit:   limit,
+			}
+			if cursor != "" {
+				cursorBytes, err := hex.DecodeString(strings.TrimPrefix(cursor, "0x"))
+				if err != nil {
+					return errors.Wrap(err, "invalid cursor")
+				}
+				req.Cursor = cursorBytes
+			}
+			var resp address_mapper.ListMappingPageResponse
+			err := cli.StaticCallContractWithFlags(&flags, AddressMapperName, "ListMappingPage", req, &resp)
+			if err != nil {
+				return errors.Wrap(err, "static call contract")
+			}
+
+			fmt.Printf("%-*s | %-*s \n", ml.From, "Foreign", ml.To, "Local")
 			for _, value := range resp.Mappings {
 				fmt.Printf("%-*s | %-*s\n",
-					ml.From, loom.UnmarshalAddressPB(value.From).String(),
-					ml.To, loom.UnmarshalAddressPB(value.To).String())
+					ml.From, loom.UnmarshalAddressPB(value.Foreign).String(),
+					ml.To, loom.UnmarshalAddressPB(value.Local).String())
+			}
+			if len(resp.NextCursor) > 0 {
+				fmt.Printf("\nNext page cursor: 0x%s\n", hex.EncodeToString(resp.NextCursor))
+			}
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().StringVar(&foreignChainID, "foreign-chain", "", "Only list mappings to accounts on this chain")
+	cmd.Flags().StringVar(&cursor, "cursor", "", "Cursor of the page to list, displayed after the previous page")
+	cmd.Flags().Uint32Var(&limit, "limit", 100, "Max number of mappings to list")
+	cmd.Flags().BoolVar(&legacy, "legacy", false, "List all the mappings at once, without using the mapping index")
+	return cmd
+}
+
+func MappingCountCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var foreignChainID string
+	cmd := &cobra.Command{
+		Use:   "mapping-count",
+		Short: "Display the number of user account mappings",
+		Args:  cobra.MinimumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp address_mapper.GetMappingCountResponse
+			err := cli.StaticCallContractWithFlags(&flags, AddressMapperName, "GetMappingCount",
+				&address_mapper.GetMappingCountRequest{
+					ChainId: foreignChainID,
+				}, &resp)
+			if err != nil {
+				return errors.Wrap(err, "static call contract")
 			}
+			fmt.Println(resp.Count)
 			return nil
 		},
 	}
 	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().StringVar(&foreignChainID, "foreign-chain", "", "Only count mappings to accounts on this chain")
 	return cmd
 }
 
@@ -172,6 +243,7 @@ func NewAddressMapperCommand() *cobra.Command {
 		AddIdentityMappingCmd(),
 		GetMapping(),
 		ListMappingCmd(),
+		MappingCountCmd(),
 	)
 	return cmd
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/loomnetwork/go-loom/config"
 	plugintypes "github.com/loomnetwork/go-loom/plugin/types"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/config/settings"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -42,6 +43,7 @@ func NewChainCfgCommand() *cobra.Command {
 		FeatureEnabledCmd(),
 		RemoveFeatureCmd(),
 		SetSettingCmd(),
+		ListSettingsCmd(),
 		ListPendingActionsCmd(),
 		ChainConfigCmd(),
 		SetValidatorInfoCmd(),
@@ -485,6 +487,13 @@ func SetSettingCmd() *cobra.Command {
 			}
 
 			// validate config setting
+			setting, err := settings.Get(args[0])
+			if err != nil {
+				return err
+			}
+			if err := setting.Validate(value); err != nil {
+				return err
+			}
 			defaultConfig := config.DefaultConfig()
 			if err := config.SetConfigSetting(defaultConfig, args[0], value); err != nil {
 				return err
@@ -496,7 +505,7 @@ func SetSettingCmd() *cobra.Command {
 				BuildNumber: buildNumber,
 			}
 
-			err := cli.CallContractWithFlags(&flags, chainConfigContractName, "SetSetting", req, nil)
+			err = cli.CallContractWithFlags(&flags, chainConfigContractName, "SetSetting", req, nil)
 			if err != nil {
 				return err
 			}
@@ -512,6 +521,38 @@ func SetSettingCmd() *cobra.Command {
 	return cmd
 }
 
+const listSettingsCmdExample = `
+loom chain-cfg list-settings
+`
+
+func ListSettingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-settings",
+		Short:   "Show the config settings that can be changed via set-setting",
+		Example: listSettingsCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, s := range settings.DefaultRegistry.List() {
+				fmt.Printf("%s (%s)\n", s.Name, s.Kind)
+				fmt.Printf("  %s\n", s.Description)
+				fmt.Printf("  default: %s\n", s.Default())
+				if bounds := s.Bounds(); bounds != "" {
+					fmt.Printf("  range: %s\n", bounds)
+				}
+				if s.Feature != "" {
+					fmt.Printf("  requires feature: %s\n", s.Feature)
+				}
+				if len(s.Components) > 0 {
+					fmt.Printf("  reloaded by: %s\n", strings.Join(s.Components, ", "))
+				} else {
+					fmt.Printf("  read from the on-chain config when used\n")
+				}
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
 const listValidatorsInfoCmdExample = `
 loom chain-cfg list-validators 
 `
@@ -1,13 +1,20 @@
 package main
 
 import (
+	"encoding/csv"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/loomnetwork/go-loom/builtin/types/coin"
 	"github.com/loomnetwork/go-loom/cli"
 	"github.com/loomnetwork/go-loom/types"
+	lcoin "github.com/loomnetwork/loomchain/builtin/plugins/coin"
 )
 
 const CoinContractName = "coin"
@@ -172,6 +179,221 @@ func BalanceCmd() *cobra.Command {
 	return cmd
 }
 
+const batchTransferCmdExample = `
+loom coin batch-transfer payouts.csv
+
+The CSV file must contain one transfer per line, in the form: address,amount
+Lines starting with # are ignored.
+`
+
+func BatchTransferCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var batchSize int
+	cmd := &cobra.Command{
+		Use:     "batch-transfer <csv>",
+		Short:   "Transfer coins to multiple accounts, in batches of up to --batch-size transfers per tx",
+		Example: batchTransferCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if batchSize < 1 || batchSize > lcoin.MaxBatchTransferSize {
+				return fmt.Errorf("batch size must be between 1 and %d", lcoin.MaxBatchTransferSize)
+			}
+			transfers, err := readBatchTransferCSV(args[0], flags.ChainID, flags.URI)
+			if err != nil {
+				return err
+			}
+			if len(transfers) == 0 {
+				return errors.New("no transfers found")
+			}
+
+			// Each batch is applied atomically, if a batch fails the remaining batches aren't sent so
+			// the transfers that didn't go through can be retried from the reported transfer onwards.
+			for start := 0; start < len(transfers); start += batchSize {
+				end := start + batchSize
+				if end > len(transfers) {
+					end = len(transfers)
+				}
+				err := cli.CallContractWithFlags(&flags, CoinContractName, "BatchTransfer",
+					&lcoin.BatchTransferRequest{Transfers: transfers[start:end]}, nil)
+				if err != nil {
+					return errors.Wrapf(err, "failed to send transfers %d-%d", start+1, end)
+				}
+				fmt.Printf("Sent transfers %d-%d of %d\n", start+1, end, len(transfers))
+			}
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().IntVar(&batchSize, "batch-size", 100, "Max number of transfers to send in a single tx")
+	return cmd
+}
+
+func readBatchTransferCSV(path, chainID, uri string) ([]*lcoin.BatchTransferEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+
+	var transfers []*lcoin.BatchTransferEntry
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		n := len(transfers) + 1
+		addr, err := cli.ResolveAddress(strings.TrimSpace(record[0]), chainID, uri)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address in transfer %d", n)
+		}
+		amount, err := cli.ParseAmount(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid amount in transfer %d", n)
+		}
+		transfers = append(transfers, &lcoin.BatchTransferEntry{
+			To:     addr.MarshalPB(),
+			Amount: &types.BigUInt{Value: *amount},
+		})
+	}
+	return transfers, nil
+}
+
+const listHoldersCmdExample = `
+loom coin holders --limit 500
+loom coin holders --cursor 0x0a1f0a0764656661756c7412144d6f4ab4a7ecb3a3e0f26d68afeb3b0dc0be2ae1
+`
+
+func ListHoldersCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var cursor string
+	var limit uint32
+	cmd := &cobra.Command{
+		Use:     "holders",
+		Short:   "List accounts with a non-zero balance",
+		Example: listHoldersCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cursorBytes, err := decodeHolderCursor(cursor)
+			if err != nil {
+				return err
+			}
+			var resp lcoin.ListHoldersResponse
+			err = cli.StaticCallContractWithFlags(&flags, CoinContractName, "ListHolders", &lcoin.ListHoldersRequest{
+				Cursor: cursorBytes,
+				Limit:  limit,
+			}, &resp)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&lcoin.ListHoldersResponse{Holders: resp.Holders})
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			if len(resp.NextCursor) > 0 {
+				fmt.Printf("\nNext page cursor: 0x%s\n", hex.EncodeToString(resp.NextCursor))
+			}
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().StringVar(&cursor, "cursor", "", "Cursor of the page to list, displayed after the previous page")
+	cmd.Flags().Uint32Var(&limit, "limit", 100, "Max number of holders to list")
+	return cmd
+}
+
+func TopHoldersCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var cursor string
+	var limit uint32
+	cmd := &cobra.Command{
+		Use:   "top-holders",
+		Short: "List accounts with a non-zero balance, ordered by balance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cursorBytes, err := decodeHolderCursor(cursor)
+			if err != nil {
+				return err
+			}
+			var resp lcoin.TopHoldersResponse
+			err = cli.StaticCallContractWithFlags(&flags, CoinContractName, "TopHolders", &lcoin.TopHoldersRequest{
+				Cursor: cursorBytes,
+				Limit:  limit,
+			}, &resp)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&lcoin.TopHoldersResponse{Holders: resp.Holders, Total: resp.Total})
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			if len(resp.NextCursor) > 0 {
+				fmt.Printf("\nNext page cursor: 0x%s\n", hex.EncodeToString(resp.NextCursor))
+			}
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().StringVar(&cursor, "cursor", "", "Cursor of the page to list, displayed after the previous page")
+	cmd.Flags().Uint32Var(&limit, "limit", 100, "Max number of holders to list")
+	return cmd
+}
+
+func decodeHolderCursor(cursor string) ([]byte, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	cursorBytes, err := hex.DecodeString(strings.TrimPrefix(cursor, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cursor")
+	}
+	return cursorBytes, nil
+}
+
+func TransferHistoryCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var cursor uint64
+	var limit uint32
+	cmd := &cobra.Command{
+		Use:   "transfer-history [address]",
+		Short: "List transfers to & from an account, newest first",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ResolveAddress(args[0], flags.ChainID, flags.URI)
+			if err != nil {
+				return err
+			}
+			var resp lcoin.TransferHistoryResponse
+			err = cli.StaticCallContractWithFlags(&flags, CoinContractName, "TransferHistory",
+				&lcoin.TransferHistoryRequest{
+					Owner:  addr.MarshalPB(),
+					Cursor: cursor,
+					Limit:  limit,
+				}, &resp)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().Uint64Var(&cursor, "cursor", 0, "Cursor of the page to list (next_cursor of the previous page)")
+	cmd.Flags().Uint32Var(&limit, "limit", 100, "Max number of transfers to list")
+	return cmd
+}
+
 func NewCoinCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "coin <command>",
@@ -184,6 +406,10 @@ func NewCoinCommand() *cobra.Command {
 		BalanceCmd(),
 		TransferCmd(),
 		TransferFromCmd(),
+		BatchTransferCmd(),
+		ListHoldersCmd(),
+		TopHoldersCmd(),
+		TransferHistoryCmd(),
 	)
 	return cmd
 }
@@ -2,9 +2,11 @@ package main
 
 import (
 	"encoding/base64"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -14,6 +16,7 @@ import (
 	"github.com/loomnetwork/go-loom/builtin/types/dposv3"
 	"github.com/loomnetwork/go-loom/cli"
 	"github.com/loomnetwork/go-loom/types"
+	dposv3plugin "github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -155,6 +158,7 @@ loom dpos3 list-candidates
 
 func ListCandidatesCmdV3() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var showPendingFees bool
 	cmd := &cobra.Command{
 		Use:     "list-candidates",
 		Short:   "List the registered candidates",
@@ -171,11 +175,40 @@ func ListCandidatesCmdV3() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			fmt.Println(out)
+			if !showPendingFees {
+				fmt.Println(out)
+				return nil
+			}
+
+			var feesResp dposv3plugin.ListPendingFeeChangesResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "ListPendingFeeChanges",
+				&dposv3plugin.ListPendingFeeChangesRequest{}, &feesResp,
+			)
+			if err != nil {
+				return err
+			}
+			feesOut, err := formatJSON(&feesResp)
+			if err != nil {
+				return err
+			}
+			combined, err := json.MarshalIndent(struct {
+				Candidates        json.RawMessage `json:"candidates"`
+				PendingFeeChanges json.RawMessage `json:"pendingFeeChanges"`
+			}{
+				Candidates:        json.RawMessage(out),
+				PendingFeeChanges: json.RawMessage(feesOut),
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(combined))
 			return nil
 		},
 	}
-	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	cmdFlags := cmd.Flags()
+	cmdFlags.BoolVar(&showPendingFees, "pending-fees", false, "Include fee changes that haven't been fully applied yet")
+	cli.AddContractStaticCallFlags(cmdFlags, &flags)
 	return cmd
 }
 
@@ -972,6 +1005,957 @@ func RedelegateAllDelegationsCmd() *cobra.Command {
 	return cmd
 }
 
+const delegateLiquidCmdExample = `
+loom dpos3 delegate-liquid 0x7262d4c97c7B93937E4810D289b7320e9dA82857 100 -k path/to/private_key
+`
+
+func DelegateLiquidCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "delegate-liquid [validator address] [amount]",
+		Short:   "delegate tokens to a validator's liquid stake pool in exchange for transferable pool shares",
+		Example: delegateLiquidCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			amount, err := cli.ParseAmount(args[1])
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3plugin.DelegateLiquidResponse
+			err = cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "DelegateLiquid",
+				&dposv3plugin.DelegateLiquidRequest{
+					ValidatorAddress: addr.MarshalPB(),
+					Amount:           &types.BigUInt{Value: *amount},
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const redeemLiquidStakeCmdExample = `
+loom dpos3 redeem-liquid 0x7262d4c97c7B93937E4810D289b7320e9dA82857 100 -k path/to/private_key
+`
+
+func RedeemLiquidStakeCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "redeem-liquid [validator address] [shares]",
+		Short:   "redeem liquid stake pool shares for the tokens they're worth",
+		Example: redeemLiquidStakeCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			shares, err := cli.ParseAmount(args[1])
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3plugin.RedeemLiquidStakeResponse
+			err = cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "RedeemLiquidStake",
+				&dposv3plugin.RedeemLiquidStakeRequest{
+					ValidatorAddress: addr.MarshalPB(),
+					Shares:           &types.BigUInt{Value: *shares},
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const transferLiquidStakeCmdExample = `
+loom dpos3 transfer-liquid 0x7262d4c97c7B93937E4810D289b7320e9dA82857 0x62666100f8988238d81831dc543D098572F283A1 100 -k path/to/private_key
+`
+
+func TransferLiquidStakeCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "transfer-liquid [validator address] [to address] [shares]",
+		Short:   "transfer liquid stake pool shares to another account",
+		Example: transferLiquidStakeCmdExample,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			toAddr, err := cli.ResolveAccountAddress(args[1], &flags)
+			if err != nil {
+				return err
+			}
+			shares, err := cli.ParseAmount(args[2])
+			if err != nil {
+				return err
+			}
+
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "TransferLiquidStake",
+				&dposv3plugin.TransferLiquidStakeRequest{
+					ValidatorAddress: validatorAddr.MarshalPB(),
+					To:               toAddr.MarshalPB(),
+					Shares:           &types.BigUInt{Value: *shares},
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const approveLiquidStakeCmdExample = `
+loom dpos3 approve-liquid 0x7262d4c97c7B93937E4810D289b7320e9dA82857 0x62666100f8988238d81831dc543D098572F283A1 100 -k path/to/private_key
+`
+
+func ApproveLiquidStakeCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "approve-liquid [validator address] [spender address] [shares]",
+		Short:   "allow another account to transfer some of your liquid stake pool shares",
+		Example: approveLiquidStakeCmdExample,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			spenderAddr, err := cli.ResolveAccountAddress(args[1], &flags)
+			if err != nil {
+				return err
+			}
+			shares, err := cli.ParseAmount(args[2])
+			if err != nil {
+				return err
+			}
+
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "ApproveLiquidStake",
+				&dposv3plugin.ApproveLiquidStakeRequest{
+					ValidatorAddress: validatorAddr.MarshalPB(),
+					Spender:          spenderAddr.MarshalPB(),
+					Shares:           &types.BigUInt{Value: *shares},
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const transferLiquidStakeFromCmdExample = `
+loom dpos3 transfer-liquid-from 0x7262d4c97c7B93937E4810D289b7320e9dA82857 0x62666100f8988238d81831dc543D098572F283A1 0x5cecd1f7261e1f4c684e297be3edf03b825e01c4 100 -k path/to/private_key
+`
+
+func TransferLiquidStakeFromCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "transfer-liquid-from [validator address] [from address] [to address] [shares]",
+		Short:   "transfer liquid stake pool shares you've been approved to transfer by their owner",
+		Example: transferLiquidStakeFromCmdExample,
+		Args:    cobra.MinimumNArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			fromAddr, err := cli.ResolveAccountAddress(args[1], &flags)
+			if err != nil {
+				return err
+			}
+			toAddr, err := cli.ResolveAccountAddress(args[2], &flags)
+			if err != nil {
+				return err
+			}
+			shares, err := cli.ParseAmount(args[3])
+			if err != nil {
+				return err
+			}
+
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "TransferLiquidStakeFrom",
+				&dposv3plugin.TransferLiquidStakeFromRequest{
+					ValidatorAddress: validatorAddr.MarshalPB(),
+					From:             fromAddr.MarshalPB(),
+					To:               toAddr.MarshalPB(),
+					Shares:           &types.BigUInt{Value: *shares},
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const liquidStakeAllowanceCmdExample = `
+loom dpos3 liquid-allowance 0x7262d4c97c7B93937E4810D289b7320e9dA82857 0x62666100f8988238d81831dc543D098572F283A1 0x5cecd1f7261e1f4c684e297be3edf03b825e01c4
+`
+
+func LiquidStakeAllowanceCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "liquid-allowance [validator address] [owner address] [spender address]",
+		Short:   "check how many of the owner's liquid stake pool shares the spender can transfer",
+		Example: liquidStakeAllowanceCmdExample,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			ownerAddr, err := cli.ResolveAccountAddress(args[1], &flags)
+			if err != nil {
+				return err
+			}
+			spenderAddr, err := cli.ResolveAccountAddress(args[2], &flags)
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3plugin.LiquidStakeAllowanceResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "LiquidStakeAllowance",
+				&dposv3plugin.LiquidStakeAllowanceRequest{
+					ValidatorAddress: validatorAddr.MarshalPB(),
+					Owner:            ownerAddr.MarshalPB(),
+					Spender:          spenderAddr.MarshalPB(),
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const checkLiquidStakeCmdExample = `
+loom dpos3 check-liquid 0x7262d4c97c7B93937E4810D289b7320e9dA82857 0x62666100f8988238d81831dc543D098572F283A1
+`
+
+func CheckLiquidStakeCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "check-liquid [validator address] [owner address]",
+		Short:   "check the liquid stake pool shares owned by an account, and their current value",
+		Example: checkLiquidStakeCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			ownerAddr, err := cli.ResolveAccountAddress(args[1], &flags)
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3plugin.CheckLiquidStakeResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "CheckLiquidStake",
+				&dposv3plugin.CheckLiquidStakeRequest{
+					ValidatorAddress: validatorAddr.MarshalPB(),
+					Owner:            ownerAddr.MarshalPB(),
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func ListLiquidRedemptionsCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "list-liquid-redemptions",
+		Short: "list liquid stake redemptions that will be paid out at the end of the next election",
+		Args:  cobra.MinimumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp dposv3plugin.ListLiquidRedemptionsResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "ListLiquidRedemptions",
+				&dposv3plugin.ListLiquidRedemptionsRequest{}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const setAutoCompoundCmdExample = `
+loom dpos3 set-auto-compound 0x7262d4c97c7B93937E4810D289b7320e9dA82857 true -k path/to/private_key
+`
+
+func SetAutoCompoundCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "set-auto-compound [validator address] [true|false]",
+		Short:   "enable or disable auto-compounding of the rewards earned by delegations to a validator",
+		Example: setAutoCompoundCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "SetAutoCompound",
+				&dposv3plugin.SetAutoCompoundRequest{
+					ValidatorAddress: addr.MarshalPB(),
+					Enabled:          enabled,
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const checkAutoCompoundCmdExample = `
+loom dpos3 check-auto-compound 0x7262d4c97c7B93937E4810D289b7320e9dA82857 0x62666100f8988238d81831dc543D098572F283A1
+`
+
+func CheckAutoCompoundCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "check-auto-compound [validator address] [delegator address]",
+		Short:   "check if auto-compounding is enabled for delegations to a validator",
+		Example: checkAutoCompoundCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			delegatorAddr, err := cli.ResolveAccountAddress(args[1], &flags)
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3plugin.CheckAutoCompoundResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "CheckAutoCompound",
+				&dposv3plugin.CheckAutoCompoundRequest{
+					ValidatorAddress: validatorAddr.MarshalPB(),
+					DelegatorAddress: delegatorAddr.MarshalPB(),
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const setFeeLimitsCmdExample = `
+loom dpos3 set-fee-limits 2000 500 -k path/to/private_key
+`
+
+func SetCandidateFeeLimitsCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "set-fee-limits [max fee (in basis points)] [max fee increase per election (in basis points)]",
+		Short:   "Sets or tightens the limits on a validator's fee, once set the limits can't be loosened",
+		Example: setFeeLimitsCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			maxFee, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxFeeChange, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			if maxFee > 10000 || maxFeeChange > 10000 {
+				return errors.New("fee limits are expressed in basis points and must be between 10000 (100%) and 0 (0%)")
+			}
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "SetCandidateFeeLimits", &dposv3plugin.SetCandidateFeeLimitsRequest{
+					MaxFee:       maxFee,
+					MaxFeeChange: maxFeeChange,
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const getFeeLimitsCmdExample = `
+loom dpos3 get-fee-limits 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+`
+
+func GetCandidateFeeLimitsCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "get-fee-limits [validator address]",
+		Short:   "Displays the limits on a validator's fee",
+		Example: getFeeLimitsCmdExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			var resp dposv3plugin.GetCandidateFeeLimitsResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetCandidateFeeLimits",
+				&dposv3plugin.GetCandidateFeeLimitsRequest{
+					Candidate: validatorAddr.MarshalPB(),
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const rewardHistoryCmdExample = `
+loom dpos3 reward-history --delegator 0x751481F4db7240f4d5ab5d8c3A5F6F099C824863 --csv
+loom dpos3 reward-history --validator 0x7262d4c97c7B93937E4810D289b7320e9dA82857 --cursor 120:0 --limit 50
+`
+
+func RewardHistoryCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var delegatorArg, validatorArg string
+	var cursorArg string
+	var limit uint64
+	var outputCSV bool
+	cmd := &cobra.Command{
+		Use:     "reward-history",
+		Short:   "Displays the per-election reward history of a delegator or validator",
+		Example: rewardHistoryCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cursor, err := parseHistoryCursor(cursorArg)
+			if err != nil {
+				return err
+			}
+			req := &dposv3plugin.GetRewardHistoryRequest{
+				Cursor: cursor,
+				Limit:  limit,
+			}
+			if delegatorArg != "" {
+				delegatorAddr, err := cli.ResolveAccountAddress(delegatorArg, &flags)
+				if err != nil {
+					return err
+				}
+				req.Delegator = delegatorAddr.MarshalPB()
+			}
+			if validatorArg != "" {
+				validatorAddr, err := cli.ParseAddress(validatorArg, flags.ChainID)
+				if err != nil {
+					return err
+				}
+				req.Validator = validatorAddr.MarshalPB()
+			}
+			if req.Delegator == nil && req.Validator == nil {
+				return errors.New("delegator or validator address must be specified")
+			}
+
+			var resp dposv3plugin.GetRewardHistoryResponse
+			err = cli.StaticCallContractWithFlags(&flags, DPOSV3ContractName, "GetRewardHistory", req, &resp)
+			if err != nil {
+				return err
+			}
+
+			if !outputCSV {
+				out, err := formatJSON(&resp)
+				if err != nil {
+					return err
+				}
+				fmt.Println(out)
+				return nil
+			}
+
+			w := csv.NewWriter(os.Stdout)
+			if err := w.Write([]string{
+				"election_time", "block_height", "validator", "delegator", "amount", "validator_share",
+			}); err != nil {
+				return err
+			}
+			for _, entry := range resp.Entries {
+				if err := w.Write([]string{
+					time.Unix(entry.ElectionTime, 0).UTC().Format(time.RFC3339),
+					strconv.FormatInt(entry.BlockHeight, 10),
+					formatHistoryAddress(entry.Validator),
+					formatHistoryAddress(entry.Delegator),
+					formatHistoryAmount(entry.Amount),
+					formatHistoryAmount(entry.ValidatorShare),
+				}); err != nil {
+					return err
+				}
+			}
+			w.Flush()
+			if err := w.Error(); err != nil {
+				return err
+			}
+			printNextHistoryCursor(resp.NextCursor)
+			return nil
+		},
+	}
+	cmdFlags := cmd.Flags()
+	cmdFlags.StringVar(&delegatorArg, "delegator", "", "Delegator address")
+	cmdFlags.StringVar(&validatorArg, "validator", "", "Validator address")
+	cmdFlags.StringVar(&cursorArg, "cursor", "", "Cursor returned with the previous page, in the form election:index")
+	cmdFlags.Uint64Var(&limit, "limit", 100, "Maximum number of entries to display")
+	cmdFlags.BoolVar(&outputCSV, "csv", false, "Output the history in CSV format")
+	cli.AddContractStaticCallFlags(cmdFlags, &flags)
+	return cmd
+}
+
+const delegationHistoryCmdExample = `
+loom dpos3 delegation-history 0x751481F4db7240f4d5ab5d8c3A5F6F099C824863 --csv
+`
+
+func DelegationHistoryCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var validatorArg string
+	var cursorArg string
+	var limit uint64
+	var outputCSV bool
+	cmd := &cobra.Command{
+		Use:     "delegation-history [delegator address]",
+		Short:   "Displays the changes made to a delegator's delegations at the end of each election",
+		Example: delegationHistoryCmdExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegatorAddr, err := cli.ResolveAccountAddress(args[0], &flags)
+			if err != nil {
+				return err
+			}
+			cursor, err := parseHistoryCursor(cursorArg)
+			if err != nil {
+				return err
+			}
+			req := &dposv3plugin.GetDelegationHistoryRequest{
+				Delegator: delegatorAddr.MarshalPB(),
+				Cursor:    cursor,
+				Limit:     limit,
+			}
+			if validatorArg != "" {
+				validatorAddr, err := cli.ParseAddress(validatorArg, flags.ChainID)
+				if err != nil {
+					return err
+				}
+				req.Validator = validatorAddr.MarshalPB()
+			}
+
+			var resp dposv3plugin.GetDelegationHistoryResponse
+			err = cli.StaticCallContractWithFlags(&flags, DPOSV3ContractName, "GetDelegationHistory", req, &resp)
+			if err != nil {
+				return err
+			}
+
+			if !outputCSV {
+				out, err := formatJSON(&resp)
+				if err != nil {
+					return err
+				}
+				fmt.Println(out)
+				return nil
+			}
+
+			w := csv.NewWriter(os.Stdout)
+			if err := w.Write([]string{
+				"election_time", "block_height", "validator", "delegator", "index", "change", "amount",
+				"balance", "previous_validator",
+			}); err != nil {
+				return err
+			}
+			for _, entry := range resp.Entries {
+				if err := w.Write([]string{
+					time.Unix(entry.ElectionTime, 0).UTC().Format(time.RFC3339),
+					strconv.FormatInt(entry.BlockHeight, 10),
+					formatHistoryAddress(entry.Validator),
+					formatHistoryAddress(entry.Delegator),
+					strconv.FormatUint(entry.Index, 10),
+					entry.Change.String(),
+					formatHistoryAmount(entry.Amount),
+					formatHistoryAmount(entry.Balance),
+					formatHistoryAddress(entry.PreviousValidator),
+				}); err != nil {
+					return err
+				}
+			}
+			w.Flush()
+			if err := w.Error(); err != nil {
+				return err
+			}
+			printNextHistoryCursor(resp.NextCursor)
+			return nil
+		},
+	}
+	cmdFlags := cmd.Flags()
+	cmdFlags.StringVar(&validatorArg, "validator", "", "Only display delegations to this validator")
+	cmdFlags.StringVar(&cursorArg, "cursor", "", "Cursor returned with the previous page, in the form election:index")
+	cmdFlags.Uint64Var(&limit, "limit", 100, "Maximum number of entries to display")
+	cmdFlags.BoolVar(&outputCSV, "csv", false, "Output the history in CSV format")
+	cli.AddContractStaticCallFlags(cmdFlags, &flags)
+	return cmd
+}
+
+// Parses a history cursor in the form election:index, returns nil if the cursor is empty.
+func parseHistoryCursor(s string) (*dposv3plugin.HistoryCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid cursor %s, expected election:index", s)
+	}
+	election, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cursor election")
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid cursor index")
+	}
+	return &dposv3plugin.HistoryCursor{Election: election, Index: index}, nil
+}
+
+// The CSV output only contains the entries, so the cursor of the next page is written to stderr.
+func printNextHistoryCursor(cursor *dposv3plugin.HistoryCursor) {
+	if cursor != nil {
+		fmt.Fprintf(os.Stderr, "Next page: --cursor %d:%d\n", cursor.Election, cursor.Index)
+	}
+}
+
+func formatHistoryAddress(addr *types.Address) string {
+	if addr == nil {
+		return ""
+	}
+	return loom.UnmarshalAddressPB(addr).String()
+}
+
+func formatHistoryAmount(amount *types.BigUInt) string {
+	if amount == nil || amount.Value.Int == nil {
+		return "0"
+	}
+	return amount.Value.String()
+}
+
+const setRedelegationLimitsCmdExample = `
+loom dpos3 set-redelegation-limits 86400 1000 -k path/to/private_key
+`
+
+func SetRedelegationLimitsCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "set-redelegation-limits [cooldown (in seconds)] [max redelegation per election (in basis points)]",
+		Short:   "Sets the redelegation cooldown & the max percentage of a validator's stake that can be redelegated per election",
+		Long:    "A max redelegation percentage of 0 removes the per-election redelegation limit",
+		Example: setRedelegationLimitsCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cooldown, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxPercentage, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			if maxPercentage > 10000 {
+				return errors.New("max redelegation percentage is expressed in basis points and must be between 10000 (100%) and 0 (0%)")
+			}
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "SetRedelegationLimits", &dposv3plugin.SetRedelegationLimitsRequest{
+					Limits: &dposv3plugin.RedelegationLimits{
+						Cooldown:                  cooldown,
+						MaxRedelegationPercentage: maxPercentage,
+					},
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const listRedelegationQueueCmdExample = `
+loom dpos3 list-redelegation-queue --delegator 0x751481F4db7240f4d5ab5d8c3A5F6F099C824863
+`
+
+func ListRedelegationQueueCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var delegatorArg string
+	cmd := &cobra.Command{
+		Use:     "list-redelegation-queue",
+		Short:   "Displays the redelegations that will be applied in upcoming elections, and the redelegation limits",
+		Example: listRedelegationQueueCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &dposv3plugin.ListRedelegationQueueRequest{}
+			if delegatorArg != "" {
+				delegatorAddr, err := cli.ParseAddress(delegatorArg, flags.ChainID)
+				if err != nil {
+					return err
+				}
+				req.Delegator = delegatorAddr.MarshalPB()
+			}
+			var resp dposv3plugin.ListRedelegationQueueResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "ListRedelegationQueue", req, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&delegatorArg, "delegator", "", "Only list the redelegations of this delegator")
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const submitProposalCmdExample = `
+loom dpos3 submit-proposal validator-count 25 "Increase the number of validators" -k path/to/private_key
+loom dpos3 submit-proposal max-yearly-reward 50000000 -k path/to/private_key
+`
+
+var proposalParams = map[string]dposv3plugin.ProposalParam{
+	"election-cycle":    dposv3plugin.ProposalParam_ELECTION_CYCLE_LENGTH,
+	"validator-count":   dposv3plugin.ProposalParam_VALIDATOR_COUNT,
+	"max-yearly-reward": dposv3plugin.ProposalParam_MAX_YEARLY_REWARD,
+	"downtime-period":   dposv3plugin.ProposalParam_DOWNTIME_PERIOD,
+	"max-fee-change":    dposv3plugin.ProposalParam_MAX_FEE_CHANGE_PER_ELECTION,
+}
+
+func SubmitProposalCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [election-cycle|validator-count|max-yearly-reward|downtime-period|max-fee-change] [value] [description]",
+		Short: "Submits a proposal to change a DPOS parameter, the proposal is executed if it passes a stake-weighted vote",
+		Long: "The election cycle is specified in seconds, the downtime period in blocks, the max yearly " +
+			"reward in tokens, and the max fee change per election in basis points.",
+		Example: submitProposalCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			param, ok := proposalParams[args[0]]
+			if !ok {
+				return fmt.Errorf("unsupported param %s", args[0])
+			}
+			var value *loom.BigUInt
+			if param == dposv3plugin.ProposalParam_MAX_YEARLY_REWARD {
+				amount, err := cli.ParseAmount(args[1])
+				if err != nil {
+					return err
+				}
+				value = amount
+			} else {
+				v, err := strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+				value = loom.NewBigUIntFromInt(v)
+			}
+			req := &dposv3plugin.SubmitProposalRequest{
+				Param: param,
+				Value: &types.BigUInt{Value: *value},
+			}
+			if len(args) >= 3 {
+				req.Description = args[2]
+			}
+			var resp dposv3plugin.SubmitProposalResponse
+			err := cli.CallContractWithFlags(&flags, DPOSV3ContractName, "SubmitProposal", req, &resp)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Submitted proposal %d\n", resp.ProposalId)
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const voteOnProposalCmdExample = `
+loom dpos3 vote-proposal 1 yes -k path/to/private_key
+`
+
+func VoteOnProposalCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "vote-proposal [proposal ID] [yes|no]",
+		Short:   "Votes for or against a DPOS parameter change proposal, the vote is weighted by the voter's delegated stake",
+		Example: voteOnProposalCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var approve bool
+			switch strings.ToLower(args[1]) {
+			case "yes":
+				approve = true
+			case "no":
+				approve = false
+			default:
+				return errors.New("vote must be either yes or no")
+			}
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "VoteOnProposal", &dposv3plugin.VoteOnProposalRequest{
+					ProposalId: proposalID,
+					Approve:    approve,
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const listProposalsCmdExample = `
+loom dpos3 list-proposals --active
+`
+
+func ListProposalsCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var activeOnly bool
+	cmd := &cobra.Command{
+		Use:     "list-proposals",
+		Short:   "Displays DPOS parameter change proposals",
+		Example: listProposalsCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp dposv3plugin.ListProposalsResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "ListProposals",
+				&dposv3plugin.ListProposalsRequest{ActiveOnly: activeOnly}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&activeOnly, "active", false, "Only list proposals that are still being voted on")
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const getProposalCmdExample = `
+loom dpos3 get-proposal 1
+`
+
+func GetProposalCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "get-proposal [proposal ID]",
+		Short:   "Displays a DPOS parameter change proposal, and the stake currently voting for & against it",
+		Example: getProposalCmdExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var resp dposv3plugin.GetProposalResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetProposal",
+				&dposv3plugin.GetProposalRequest{ProposalId: proposalID}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
 const claimDelegatorRewardsCmdExample = `
 loom dpos3 claim-delegator-rewards --key path/to/private_key
 `
@@ -1644,6 +2628,30 @@ func NewDPOSV3Command() *cobra.Command {
 		UnjailValidatorCmdV3(),
 		EnableValidatorJailingCmd(),
 		IgnoreUnbondLocktimeCmd(),
+		DelegateLiquidCmdV3(),
+		RedeemLiquidStakeCmdV3(),
+		TransferLiquidStakeCmdV3(),
+		ApproveLiquidStakeCmdV3(),
+		TransferLiquidStakeFromCmdV3(),
+		LiquidStakeAllowanceCmdV3(),
+		CheckLiquidStakeCmdV3(),
+		ListLiquidRedemptionsCmdV3(),
+		SetAutoCompoundCmdV3(),
+		CheckAutoCompoundCmdV3(),
+		SetCandidateFeeLimitsCmdV3(),
+		GetCandidateFeeLimitsCmdV3(),
+		RewardHistoryCmdV3(),
+		DelegationHistoryCmdV3(),
+		SimulateElectionCmdV3(),
+		SetRedelegationLimitsCmdV3(),
+		ListRedelegationQueueCmdV3(),
+		SubmitProposalCmdV3(),
+		VoteOnProposalCmdV3(),
+		ListProposalsCmdV3(),
+		GetProposalCmdV3(),
+		GenValidatorKeyCmdV3(),
+		RotateValidatorKeyCmdV3(),
+		CheckKeyRotationCmdV3(),
 	)
 	return cmd
 }
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path"
+
+	"github.com/loomnetwork/go-loom/cli"
+	dposv3plugin "github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/cmd/loom/common"
+	"github.com/loomnetwork/loomchain/privval"
+	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+const genValidatorKeyCmdExample = `
+loom dpos3 gen-validator-key
+loom dpos3 gen-validator-key --out /path/to/new_priv_validator.json
+`
+
+func GenValidatorKeyCmdV3() *cobra.Command {
+	var outFile string
+	cmd := &cobra.Command{
+		Use:   "gen-validator-key",
+		Short: "Generates a new validator key that the node's current validator key can be rotated to",
+		Long: "Generates a new priv validator, using the HSM if one is enabled in the node's config, " +
+			"and displays its public key which can then be passed to rotate-validator-key. " +
+			"The node's current priv validator isn't modified, once the rotation takes effect the node " +
+			"must be restarted with the new priv validator (and the new HSM sign key ID, if applicable). " +
+			"The command must be run from the node's working directory.",
+		Example: genValidatorKeyCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := common.ParseConfig()
+			if err != nil {
+				return err
+			}
+			if outFile == "" {
+				outFile = path.Join(cfg.RootPath(), "chaindata", "config", "priv_validator.next.json")
+			}
+
+			pv, err := privval.GenRotatedPrivVal(outFile, cfg.HsmConfig, cfg.RemoteSigner)
+			if err != nil {
+				return err
+			}
+			pubKey := [ed25519.PubKeyEd25519Size]byte(pv.GetPubKey().(ed25519.PubKeyEd25519))
+
+			fmt.Printf("Priv validator saved to %s\n", outFile)
+			fmt.Printf("Public key: %s\n", base64.StdEncoding.EncodeToString(pubKey[:]))
+			switch hsmPV := pv.(type) {
+			case *hsmpv.YubiHsmPV:
+				fmt.Printf("HSM sign key ID: %d\n", hsmPV.SignKeyID)
+			case *hsmpv.Pkcs11PV:
+				fmt.Printf("HSM sign key ID: %d\n", hsmPV.SignKeyID)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&outFile, "out", "", "Path to save the new priv validator to")
+	return cmd
+}
+
+const rotateValidatorKeyCmdExample = `
+loom dpos3 rotate-validator-key 2Dsg3TyIzDMhUBBwsnSNqwKJFh8aFzJE5DsYbU2hpco= -k path/to/private_key
+`
+
+func RotateValidatorKeyCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "rotate-validator-key [new public key]",
+		Short:   "Replaces a validator's key in the next election",
+		Example: rotateValidatorKeyCmdExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubKey, err := base64.StdEncoding.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "RotateValidatorKey", &dposv3plugin.RotateValidatorKeyRequest{
+					NewPubKey: pubKey,
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const checkKeyRotationCmdExample = `
+loom dpos3 check-key-rotation 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+`
+
+func CheckKeyRotationCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "check-key-rotation [validator address]",
+		Short:   "Displays a validator's key rotation that hasn't taken effect yet",
+		Example: checkKeyRotationCmdExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			var resp dposv3plugin.GetPendingKeyRotationResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetPendingKeyRotation",
+				&dposv3plugin.GetPendingKeyRotationRequest{
+					Candidate: validatorAddr.MarshalPB(),
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
@@ -0,0 +1,394 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/builtin/types/dposv3"
+	"github.com/loomnetwork/go-loom/cli"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	plugintypes "github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain"
+	dposv3plugin "github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/cmd/loom/common"
+	"github.com/loomnetwork/loomchain/config"
+	cdb "github.com/loomnetwork/loomchain/db"
+	"github.com/loomnetwork/loomchain/events"
+	"github.com/loomnetwork/loomchain/log"
+	"github.com/loomnetwork/loomchain/plugin"
+	registry "github.com/loomnetwork/loomchain/registry/factory"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+type simulatedValidator struct {
+	Address     string `json:"address"`
+	PubKey      string `json:"pubKey"`
+	PowerBefore int64  `json:"powerBefore"`
+	PowerAfter  int64  `json:"powerAfter"`
+	// Rewards credited to delegations to the validator by the simulated election
+	Rewards string `json:"rewards"`
+}
+
+type electionSimulation struct {
+	AppHeight         int64                 `json:"appHeight"`
+	ElectionTime      int64                 `json:"electionTime"`
+	ValidatorsAdded   []string              `json:"validatorsAdded"`
+	ValidatorsRemoved []string              `json:"validatorsRemoved"`
+	Validators        []*simulatedValidator `json:"validators"`
+	TotalRewards      string                `json:"totalRewards"`
+}
+
+const simulateElectionCmdExample = `
+loom dpos3 simulate-election --app-height 1234567 --validator-count 25 --max-yearly-reward 50000000
+`
+
+func SimulateElectionCmdV3() *cobra.Command {
+	var appHeight, electionTime int64
+	var validatorCount int64
+	var maxYearlyReward string
+	var crashSlashingPercentage, byzantineSlashingPercentage int64
+	cmd := &cobra.Command{
+		Use:   "simulate-election",
+		Short: "Simulates the next DPOS election using the state in app.db, without modifying app.db",
+		Long: "Loads the app state at the specified height, applies the specified DPOS parameter changes, " +
+			"runs the next election, and displays the resulting validator set & reward distribution. " +
+			"None of the changes are persisted. The command must be run from the node's working directory.",
+		Example: simulateElectionCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := common.ParseConfig()
+			if err != nil {
+				return err
+			}
+
+			appStore, err := loadSimulationAppStore(cfg, appHeight)
+			if err != nil {
+				return err
+			}
+			defer store.Close(appStore)
+
+			regVer, err := registry.RegistryVersionFromInt(cfg.RegistryVersion)
+			if err != nil {
+				return err
+			}
+			createRegistry, err := registry.NewRegistryFactory(regVer)
+			if err != nil {
+				return err
+			}
+
+			// All the changes made during the simulation are written to a cache tx that's never
+			// committed, so app.db isn't modified.
+			storeTx := store.WrapAtomic(appStore).BeginTx()
+			loader := common.NewDefaultContractsLoader(cfg)
+			compounded := &compoundedRewardsCollector{
+				EventHandler: loomchain.NewDefaultEventHandler(events.NewLogEventDispatcher()),
+				totals:       map[string]*big.Int{},
+			}
+			createDPOSContext := func(state loomchain.State, caller *loom.Address) (contract.Context, error) {
+				pvm := plugin.NewPluginVM(
+					loader,
+					state,
+					createRegistry(state),
+					compounded,
+					log.Default,
+					nil,
+					nil,
+					nil,
+				)
+				contractAddr, err := pvm.Registry.Resolve("dposV3")
+				if err != nil {
+					return nil, errors.Wrap(err, "failed to resolve DPOSv3 contract address")
+				}
+				if caller == nil {
+					rootAddr := loom.RootAddress(cfg.ChainID)
+					caller = &rootAddr
+				}
+				return contract.WrapPluginContext(pvm.CreateContractContext(*caller, contractAddr, false)), nil
+			}
+
+			header := abci.Header{
+				ChainID: cfg.ChainID,
+				Height:  appStore.Version() + 1,
+			}
+			ctx, err := createDPOSContext(
+				loomchain.NewStoreState(context.Background(), storeTx, header, nil, nil), nil,
+			)
+			if err != nil {
+				return err
+			}
+			dposState, err := dposv3plugin.LoadState(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to load DPOSv3 state")
+			}
+
+			// Unless specified otherwise the election is simulated at the earliest time it
+			// could take place.
+			if electionTime == 0 {
+				electionTime = dposState.LastElectionTime + dposState.Params.ElectionCycleLength
+			}
+			header.Time = time.Unix(electionTime, 0)
+			state := loomchain.NewStoreState(context.Background(), storeTx, header, nil, nil)
+
+			dpos := &dposv3plugin.DPOS{}
+			// The param overrides are applied via the oracle, so they can only be simulated on
+			// chains that have one.
+			overrideParams := validatorCount > 0 || maxYearlyReward != "" ||
+				crashSlashingPercentage >= 0 || byzantineSlashingPercentage >= 0
+			var oracleCtx contract.Context
+			if overrideParams {
+				if dposState.Params.OracleAddress == nil {
+					return errors.New("DPOS params can't be overridden because no oracle is set")
+				}
+				oracleAddr := loom.UnmarshalAddressPB(dposState.Params.OracleAddress)
+				oracleCtx, err = createDPOSContext(state, &oracleAddr)
+				if err != nil {
+					return err
+				}
+			}
+			if validatorCount > 0 {
+				err := dpos.SetValidatorCount(oracleCtx, &dposv3.SetValidatorCountRequest{
+					ValidatorCount: validatorCount,
+				})
+				if err != nil {
+					return errors.Wrap(err, "failed to change validator count")
+				}
+			}
+			if maxYearlyReward != "" {
+				amount, err := cli.ParseAmount(maxYearlyReward)
+				if err != nil {
+					return err
+				}
+				err = dpos.SetMaxYearlyReward(oracleCtx, &dposv3.SetMaxYearlyRewardRequest{
+					MaxYearlyReward: &types.BigUInt{Value: *amount},
+				})
+				if err != nil {
+					return errors.Wrap(err, "failed to change max yearly reward")
+				}
+			}
+			if crashSlashingPercentage >= 0 || byzantineSlashingPercentage >= 0 {
+				req := &dposv3.SetSlashingPercentagesRequest{
+					CrashSlashingPercentage:     dposState.Params.CrashSlashingPercentage,
+					ByzantineSlashingPercentage: dposState.Params.ByzantineSlashingPercentage,
+				}
+				if crashSlashingPercentage >= 0 {
+					req.CrashSlashingPercentage = &types.BigUInt{
+						Value: *loom.NewBigUIntFromInt(crashSlashingPercentage),
+					}
+				}
+				if byzantineSlashingPercentage >= 0 {
+					req.ByzantineSlashingPercentage = &types.BigUInt{
+						Value: *loom.NewBigUIntFromInt(byzantineSlashingPercentage),
+					}
+				}
+				if err := dpos.SetSlashingPercentages(oracleCtx, req); err != nil {
+					return errors.Wrap(err, "failed to change slashing percentages")
+				}
+			}
+
+			ctx, err = createDPOSContext(state, nil)
+			if err != nil {
+				return err
+			}
+			validatorsBefore, err := dposv3plugin.ValidatorList(ctx)
+			if err != nil {
+				return err
+			}
+			rewardsBefore, err := rewardDelegationTotals(ctx, dpos)
+			if err != nil {
+				return err
+			}
+
+			if err := dposv3plugin.Elect(ctx); err != nil {
+				return errors.Wrap(err, "simulated election failed")
+			}
+
+			validatorsAfter, err := dposv3plugin.ValidatorList(ctx)
+			if err != nil {
+				return err
+			}
+			rewardsAfter, err := rewardDelegationTotals(ctx, dpos)
+			if err != nil {
+				return err
+			}
+			addRewardTotals(rewardsAfter, compounded.totals)
+
+			result := &electionSimulation{
+				AppHeight:         appStore.Version(),
+				ElectionTime:      electionTime,
+				ValidatorsAdded:   make([]string, 0),
+				ValidatorsRemoved: make([]string, 0),
+				Validators:        make([]*simulatedValidator, 0),
+			}
+			validators := map[string]*simulatedValidator{}
+			getValidator := func(pubKey []byte) *simulatedValidator {
+				addr := loom.Address{ChainID: cfg.ChainID, Local: loom.LocalAddressFromPublicKey(pubKey)}
+				v, ok := validators[addr.String()]
+				if !ok {
+					v = &simulatedValidator{
+						Address: addr.String(),
+						PubKey:  hex.EncodeToString(pubKey),
+					}
+					validators[addr.String()] = v
+				}
+				return v
+			}
+			for _, v := range validatorsBefore {
+				getValidator(v.PubKey).PowerBefore = v.Power
+			}
+			for _, v := range validatorsAfter {
+				getValidator(v.PubKey).PowerAfter = v.Power
+			}
+			for _, v := range dposv3plugin.MissingValidators(validatorsBefore, validatorsAfter) {
+				result.ValidatorsRemoved = append(result.ValidatorsRemoved, getValidator(v.PubKey).Address)
+			}
+			for _, v := range dposv3plugin.MissingValidators(validatorsAfter, validatorsBefore) {
+				result.ValidatorsAdded = append(result.ValidatorsAdded, getValidator(v.PubKey).Address)
+			}
+
+			totalRewards := big.NewInt(0)
+			for _, v := range append(validatorsBefore, validatorsAfter...) {
+				sv := getValidator(v.PubKey)
+				if sv.Rewards != "" {
+					continue
+				}
+				rewards := big.NewInt(0)
+				if after, ok := rewardsAfter[sv.Address]; ok {
+					rewards.Add(rewards, after)
+				}
+				if before, ok := rewardsBefore[sv.Address]; ok {
+					rewards.Sub(rewards, before)
+				}
+				sv.Rewards = rewards.String()
+				totalRewards.Add(totalRewards, rewards)
+				result.Validators = append(result.Validators, sv)
+			}
+			result.TotalRewards = totalRewards.String()
+
+			out, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+	cmdFlags := cmd.Flags()
+	cmdFlags.Int64Var(&appHeight, "app-height", 0, "App height of the state to simulate the election on (defaults to latest)")
+	cmdFlags.Int64Var(&electionTime, "time", 0, "Unix timestamp of the simulated election (defaults to the next election time)")
+	cmdFlags.Int64Var(&validatorCount, "validator-count", 0, "Validator count to use in the simulated election")
+	cmdFlags.StringVar(&maxYearlyReward, "max-yearly-reward", "", "Max yearly reward to use in the simulated election")
+	cmdFlags.Int64Var(
+		&crashSlashingPercentage, "crash-slashing-percentage", -1,
+		"Crash fault slashing percentage (in basis points) to use in the simulated election",
+	)
+	cmdFlags.Int64Var(
+		&byzantineSlashingPercentage, "byzantine-slashing-percentage", -1,
+		"Byzantine fault slashing percentage (in basis points) to use in the simulated election",
+	)
+	return cmd
+}
+
+// Loads the app store at the given height. The simulation never saves a new version of the store,
+// so the node's pruning & caching layers aren't needed.
+func loadSimulationAppStore(cfg *config.Config, appHeight int64) (store.VersionedKVStore, error) {
+	db, err := cdb.LoadDB(
+		cfg.DBBackend, cfg.DBName, cfg.RootPath(),
+		cfg.DBBackendConfig.CacheSizeMegs, cfg.DBBackendConfig.WriteBufferMegs, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	iavlStore, err := store.NewIAVLStore(db, 0, appHeight, 0)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	switch cfg.AppStore.Version {
+	case 1:
+		return iavlStore, nil
+	case 3:
+		evmStore, err := loadEvmStore(cfg, iavlStore.Version())
+		if err != nil {
+			iavlStore.Close()
+			return nil, err
+		}
+		return store.NewMultiWriterAppStore(iavlStore, evmStore, cfg.AppStore.SaveEVMStateToIAVL)
+	default:
+		iavlStore.Close()
+		return nil, fmt.Errorf("unsupported app store version %d", cfg.AppStore.Version)
+	}
+}
+
+// compoundedRewardsCollector keeps track of the rewards auto-compounded during the simulated
+// election. Auto-compounded rewards are added to the delegations that earned them rather than to
+// the rewards delegations, so they're only visible in the events emitted by the election.
+type compoundedRewardsCollector struct {
+	loomchain.EventHandler
+	// total rewards compounded into delegations to each validator
+	totals map[string]*big.Int
+}
+
+func (c *compoundedRewardsCollector) Post(height uint64, e *plugintypes.EventData) error {
+	for _, topic := range e.Topics {
+		if topic != dposv3plugin.DelegatorCompoundsEventTopic {
+			continue
+		}
+		var event dposv3plugin.DposDelegatorCompoundsEvent
+		if err := proto.Unmarshal(e.EncodedBody, &event); err != nil {
+			return errors.Wrap(err, "failed to unmarshal delegator compounds event")
+		}
+		if event.Validator != nil && event.Amount != nil {
+			addRewardTotals(c.totals, map[string]*big.Int{
+				loom.UnmarshalAddressPB(event.Validator).String(): event.Amount.Value.Int,
+			})
+		}
+		break
+	}
+	return c.EventHandler.Post(height, e)
+}
+
+// Adds the per-validator amounts in src to the totals in dst.
+func addRewardTotals(dst, src map[string]*big.Int) {
+	for validator, amount := range src {
+		if total, ok := dst[validator]; ok {
+			total.Add(total, amount)
+		} else {
+			dst[validator] = new(big.Int).Set(amount)
+		}
+	}
+}
+
+// Returns the total amount held in the rewards delegations of each validator, excluding any
+// amounts that will be unbonded at the end of the next election.
+func rewardDelegationTotals(ctx contract.StaticContext, dpos *dposv3plugin.DPOS) (map[string]*big.Int, error) {
+	resp, err := dpos.ListAllDelegations(ctx, &dposv3.ListAllDelegationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]*big.Int{}
+	for _, list := range resp.ListResponses {
+		for _, d := range list.Delegations {
+			if d.Index != dposv3plugin.REWARD_DELEGATION_INDEX || d.Amount == nil {
+				continue
+			}
+			amount := new(big.Int).Set(d.Amount.Value.Int)
+			if d.State == dposv3plugin.UNBONDING && d.UpdateAmount != nil {
+				amount.Sub(amount, d.UpdateAmount.Value.Int)
+			}
+			addRewardTotals(totals, map[string]*big.Int{loom.UnmarshalAddressPB(d.Validator).String(): amount})
+		}
+	}
+	return totals, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	proto "github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	plugintypes "github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain"
+	dposv3plugin "github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/config"
+	cdb "github.com/loomnetwork/loomchain/db"
+	"github.com/loomnetwork/loomchain/events"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompoundedRewardsCollector(t *testing.T) {
+	validator1 := loom.MustParseAddress("default:0x0000000000000000000000000000000000000001")
+	validator2 := loom.MustParseAddress("default:0x0000000000000000000000000000000000000002")
+	collector := &compoundedRewardsCollector{
+		EventHandler: loomchain.NewDefaultEventHandler(events.NewLogEventDispatcher()),
+		totals:       map[string]*big.Int{},
+	}
+
+	postCompound := func(validator loom.Address, amount int64) {
+		body, err := proto.Marshal(&dposv3plugin.DposDelegatorCompoundsEvent{
+			Validator: validator.MarshalPB(),
+			Amount:    &types.BigUInt{Value: *loom.NewBigUIntFromInt(amount)},
+		})
+		require.NoError(t, err)
+		require.NoError(t, collector.Post(1, &plugintypes.EventData{
+			Topics:      []string{dposv3plugin.DelegatorCompoundsEventTopic},
+			EncodedBody: body,
+		}))
+	}
+	postCompound(validator1, 10)
+	postCompound(validator1, 5)
+	postCompound(validator2, 7)
+	// events with other topics are ignored
+	require.NoError(t, collector.Post(1, &plugintypes.EventData{
+		Topics:      []string{dposv3plugin.ElectionEventTopic},
+		EncodedBody: []byte{1, 2, 3},
+	}))
+
+	require.Equal(t, int64(15), collector.totals[validator1.String()].Int64())
+	require.Equal(t, int64(7), collector.totals[validator2.String()].Int64())
+
+	// compounded rewards are added to the rewards delegation totals
+	totals := map[string]*big.Int{validator1.String(): big.NewInt(100)}
+	addRewardTotals(totals, collector.totals)
+	require.Equal(t, int64(115), totals[validator1.String()].Int64())
+	require.Equal(t, int64(7), totals[validator2.String()].Int64())
+	// the source totals aren't modified
+	require.Equal(t, int64(15), collector.totals[validator1.String()].Int64())
+}
+
+func TestLoadSimulationAppStore(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.DBBackend = cdb.MemDBackend
+	cfg.EvmStore.DBBackend = cdb.MemDBackend
+
+	cfg.AppStore.Version = 1
+	appStore, err := loadSimulationAppStore(cfg, 0)
+	require.NoError(t, err)
+	_, ok := appStore.(*store.IAVLStore)
+	require.True(t, ok)
+
+	cfg.AppStore.Version = 3
+	appStore, err = loadSimulationAppStore(cfg, 0)
+	require.NoError(t, err)
+	_, ok = appStore.(*store.MultiWriterAppStore)
+	require.True(t, ok)
+
+	cfg.AppStore.Version = 2
+	_, err = loadSimulationAppStore(cfg, 0)
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/loomnetwork/go-loom/cli"
 	"github.com/loomnetwork/go-loom/client"
 	"github.com/loomnetwork/go-loom/common/evmcompat"
+	"github.com/loomnetwork/loomchain/builtin/plugins/address_mapper"
 	ssha "github.com/miguelmota/go-solidity-sha3"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -235,6 +236,8 @@ func newMapBinanceContractsCommand() *cobra.Command {
 const mapAccountsCmdExample = `
 ./loom gateway map-accounts	--key path/to/loom_priv.key --eth-key path/to/eth_priv.key OR
 ./loom gateway map-accounts --interactive --key path/to/loom_priv.key --eth-address <your-eth-address>
+./loom gateway map-accounts --rebind --key path/to/loom_priv.key --eth-key path/to/new_eth_priv.key
+./loom gateway map-accounts --remove --key path/to/loom_priv.key
 `
 
 const mapAccountsConfirmationMsg = `
@@ -243,12 +246,28 @@ Mapping Accounts
 Are you sure? [y/n]
 `
 
+const rebindAccountsConfirmationMsg = `
+Rebinding Accounts
+%v <-> %v (currently mapped to %v)
+Are you sure? [y/n]
+`
+
+const removeMappingConfirmationMsg = `
+Removing Account Mapping
+%v <-> %v
+Are you sure? [y/n]
+`
+
 func newMapAccountsCommand() *cobra.Command {
 	var ethAddressStr string
-	var silent, interactive bool
+	var silent, interactive, rebind, remove bool
 	cmd := &cobra.Command{
-		Use:     "map-accounts",
-		Short:   "Links a DAppChain account to an Ethereum account via the Transfer Gateway.",
+		Use:   "map-accounts",
+		Short: "Links a DAppChain account to an Ethereum account via the Transfer Gateway.",
+		Long: "Links a DAppChain account to an Ethereum account via the Transfer Gateway. " +
+			"An existing mapping can be removed with --remove, or replaced with a mapping to another Ethereum " +
+			"account with --rebind. Once a mapping has been removed or replaced it can't be changed again " +
+			"for 24 hours.",
 		Example: mapAccountsCmdExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			loomKeyPath := gatewayCmdFlags.PrivKeyPath
@@ -272,10 +291,47 @@ func newMapAccountsCommand() *cobra.Command {
 			}
 			mapper := client.NewContract(rpcClient, mapperAddr.Local)
 			mappedAccount, err := getMappedAccount(mapper, localOwnerAddr)
-			if err == nil {
+			if rebind || remove {
+				if err != nil {
+					return fmt.Errorf("Account %v is not mapped", localOwnerAddr)
+				}
+			} else if err == nil {
 				return fmt.Errorf("Account %v is already mapped to %v", localOwnerAddr, mappedAccount)
 			}
 
+			if remove {
+				if !silent {
+					ok, err := confirm(fmt.Sprintf(removeMappingConfirmationMsg, localOwnerAddr, mappedAccount))
+					if err != nil || !ok {
+						return err
+					}
+				}
+				if algo != "ed25519" {
+					return errors.New("removing a mapping requires an ed25519 key")
+				}
+				nonce, err := getMappingRemovalNonce(mapper, localOwnerAddr)
+				if err != nil {
+					return errors.Wrap(err, "failed to load mapping removal nonce")
+				}
+				// The removal must be signed by the account itself, not just by the tx signer
+				sig, err := address_mapper.EncodeSigWithPubKey(
+					address_mapper.SignatureTypeEd25519, signer.PublicKey(),
+					signer.Sign(address_mapper.MappingRemovalHash(localOwnerAddr, mappedAccount, nonce)),
+				)
+				if err != nil {
+					return err
+				}
+				req := &address_mapper.RemoveMappingRequest{
+					From:      localOwnerAddr.MarshalPB(),
+					Signature: sig,
+				}
+				if _, err := mapper.Call("RemoveMapping", req, signer, nil); err != nil {
+					return err
+				}
+				fmt.Println("...Address mapping has been successfully removed!")
+				return nil
+			}
+
 			var foreignOwnerAddr loom.Address
 			var req *amtypes.AddressMapperAddIdentityMappingRequest
 			if !interactive {
@@ -344,18 +400,30 @@ func newMapAccountsCommand() *cobra.Command {
 				}
 			}
 
-			if !silent {
-				fmt.Printf(mapAccountsConfirmationMsg, localOwnerAddr, ethAddressStr)
-				var input string
-				n, err := fmt.Scan(&input)
-				if err != nil {
-					return err
+			if rebind {
+				if !silent {
+					ok, err := confirm(
+						fmt.Sprintf(rebindAccountsConfirmationMsg, localOwnerAddr, ethAddressStr, mappedAccount),
+					)
+					if err != nil || !ok {
+						return err
+					}
 				}
-				if n != 1 {
-					return errors.New("expected y/n")
+				_, err = mapper.Call("RebindMapping", &address_mapper.RebindMappingRequest{
+					From:      req.From,
+					To:        req.To,
+					Signature: req.Signature,
+				}, signer, nil)
+				if err == nil {
+					fmt.Println("...Address has been successfully rebound!")
 				}
-				if strings.ToLower(input) != "y" && strings.ToLower(input) != "yes" {
-					return nil
+				return err
+			}
+
+			if !silent {
+				ok, err := confirm(fmt.Sprintf(mapAccountsConfirmationMsg, localOwnerAddr, ethAddressStr))
+				if err != nil || !ok {
+					return err
 				}
 			}
 
@@ -371,9 +439,25 @@ func newMapAccountsCommand() *cobra.Command {
 	cmdFlags.StringVar(&ethAddressStr, "eth-address", "", "Ethereum address of account owner")
 	cmdFlags.BoolVar(&silent, "silent", false, "Don't ask for address confirmation")
 	cmdFlags.BoolVar(&interactive, "interactive", false, "Make the mapping of an account interactive by requiring the signature to be provided by the user instead of signing inside the client.")
+	cmdFlags.BoolVar(&rebind, "rebind", false, "Replace the Ethereum account the DAppChain account is currently mapped to")
+	cmdFlags.BoolVar(&remove, "remove", false, "Remove the existing mapping of the DAppChain account")
 	return cmd
 }
 
+// confirm displays the given message and returns true if the user answers yes.
+func confirm(msg string) (bool, error) {
+	fmt.Print(msg)
+	var input string
+	n, err := fmt.Scan(&input)
+	if err != nil {
+		return false, err
+	}
+	if n != 1 {
+		return false, errors.New("expected y/n")
+	}
+	return strings.ToLower(input) == "y" || strings.ToLower(input) == "yes", nil
+}
+
 const ListContractMappingCmdExample = `
 loom gateway list-contract-mappings
 loom gateway list-contract-mappings --raw
@@ -605,6 +689,17 @@ func getMappedAccount(mapper *client.Contract, account loom.Address) (loom.Addre
 	return loom.UnmarshalAddressPB(resp.To), nil
 }
 
+func getMappingRemovalNonce(mapper *client.Contract, account loom.Address) (uint64, error) {
+	req := &address_mapper.GetMappingRemovalNonceRequest{
+		Account: account.MarshalPB(),
+	}
+	resp := &address_mapper.GetMappingRemovalNonceResponse{}
+	if _, err := mapper.StaticCall("GetMappingRemovalNonce", req, account, resp); err != nil {
+		return 0, err
+	}
+	return resp.Nonce, nil
+}
+
 func getDAppChainClient() *client.DAppChainRPCClient {
 	writeURI := gatewayCmdFlags.URI + "/rpc"
 	readURI := gatewayCmdFlags.URI + "/query"
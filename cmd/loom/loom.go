@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
@@ -17,6 +18,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus/push"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/db"
 
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
@@ -49,6 +51,7 @@ import (
 	gatewaycmd "github.com/loomnetwork/loomchain/cmd/loom/gateway"
 	userdeployer "github.com/loomnetwork/loomchain/cmd/loom/userdeployerwhitelist"
 	"github.com/loomnetwork/loomchain/config"
+	"github.com/loomnetwork/loomchain/config/settings"
 	"github.com/loomnetwork/loomchain/core"
 	cdb "github.com/loomnetwork/loomchain/db"
 	"github.com/loomnetwork/loomchain/eth/polls"
@@ -430,7 +433,15 @@ func newRunCommand() *cobra.Command {
 				return err
 			}
 
-			if err := startFeatureAutoEnabler(chainID, cfg.ChainConfig, nodeSigner, backend, log.Default); err != nil {
+			// Stops the node gracefully when it needs to halt for an upgrade, the app store has
+			// already written the last block to disk by the time this is called.
+			shutdown := func() {
+				backend.Stop()
+				loader.UnloadContracts()
+				store.Close(app.Store)
+			}
+			err = startChainConfigRoutine(chainID, cfg.ChainConfig, nodeSigner, backend, app, shutdown, log.Default)
+			if err != nil {
 				return err
 			}
 
@@ -499,20 +510,26 @@ func recovery() {
 	}
 }
 
-func startFeatureAutoEnabler(
+func startChainConfigRoutine(
 	chainID string, cfg *config.ChainConfigConfig, nodeSigner glAuth.Signer, node backend.Backend,
-	logger *loom.Logger,
+	app *loomchain.Application, shutdown func(), logger *loom.Logger,
 ) error {
-	if !cfg.AutoEnableFeatures || !cfg.ContractEnabled {
+	if !cfg.ContractEnabled || (!cfg.AutoEnableFeatures && !cfg.UpgradeHaltEnabled) {
 		return nil
 	}
 
-	routine, err := chainconfig.NewChainConfigRoutine(cfg, chainID, nodeSigner, node, logger)
+	routine, err := chainconfig.NewChainConfigRoutine(cfg, chainID, nodeSigner, node, app, shutdown, logger)
 	if err != nil {
 		return err
 	}
 
-	go routine.RunWithRecovery()
+	if cfg.AutoEnableFeatures {
+		go routine.RunWithRecovery()
+	}
+
+	if cfg.UpgradeHaltEnabled {
+		go routine.RunUpgradeWatcherWithRecovery()
+	}
 
 	return nil
 }
@@ -607,7 +624,11 @@ func destroyBlockIndexDB(cfg *config.Config) error {
 	return nil
 }
 
-func loadAppStore(cfg *config.Config, logger *loom.Logger, targetVersion int64) (store.VersionedKVStore, error) {
+// loadAppStore loads the app store, the watcher is used to keep any IAVL stores in sync with the
+// on-chain config.
+func loadAppStore(
+	cfg *config.Config, logger *loom.Logger, targetVersion int64, watcher *settings.Watcher,
+) (store.VersionedKVStore, error) {
 	db, err := cdb.LoadDB(
 		cfg.DBBackend, cfg.DBName, cfg.RootPath(), cfg.DBBackendConfig.CacheSizeMegs, cfg.DBBackendConfig.WriteBufferMegs, cfg.Metrics.Database,
 	)
@@ -629,7 +650,7 @@ func loadAppStore(cfg *config.Config, logger *loom.Logger, targetVersion int64)
 	if cfg.AppStore.Version == 1 { // TODO: cleanup these hardcoded numbers
 		if cfg.AppStore.PruneInterval > int64(0) {
 			logger.Info("Loading Pruning IAVL Store")
-			appStore, err = store.NewPruningIAVLStore(db, store.PruningIAVLStoreConfig{
+			pruningStore, err := store.NewPruningIAVLStore(db, store.PruningIAVLStoreConfig{
 				MaxVersions:   cfg.AppStore.MaxVersions,
 				BatchSize:     cfg.AppStore.PruneBatchSize,
 				Interval:      time.Duration(cfg.AppStore.PruneInterval) * time.Second,
@@ -639,12 +660,20 @@ func loadAppStore(cfg *config.Config, logger *loom.Logger, targetVersion int64)
 			if err != nil {
 				return nil, err
 			}
+			if err := watchIAVLFlushInterval(watcher, pruningStore, logger); err != nil {
+				return nil, err
+			}
+			appStore = pruningStore
 		} else {
 			logger.Info("Loading IAVL Store")
-			appStore, err = store.NewIAVLStore(db, cfg.AppStore.MaxVersions, targetVersion, cfg.AppStore.IAVLFlushInterval)
+			iavlStore, err := store.NewIAVLStore(db, cfg.AppStore.MaxVersions, targetVersion, cfg.AppStore.IAVLFlushInterval)
 			if err != nil {
 				return nil, err
 			}
+			if err := watchIAVLFlushInterval(watcher, iavlStore, logger); err != nil {
+				return nil, err
+			}
+			appStore = iavlStore
 		}
 	} else if cfg.AppStore.Version == 3 {
 		logger.Info("Loading Multi-Writer App Store")
@@ -652,6 +681,9 @@ func loadAppStore(cfg *config.Config, logger *loom.Logger, targetVersion int64)
 		if err != nil {
 			return nil, err
 		}
+		if err := watchIAVLFlushInterval(watcher, iavlStore, logger); err != nil {
+			return nil, err
+		}
 		evmStore, err := loadEvmStore(cfg, iavlStore.Version())
 		if err != nil {
 			return nil, err
@@ -697,6 +729,23 @@ func loadEventStore(cfg *config.Config, logger *loom.Logger) (store.EventStore,
 	return eventStore, nil
 }
 
+// watchIAVLFlushInterval keeps the flush interval of the given store in sync with the
+// AppStore.IAVLFlushInterval on-chain config setting. The subscription lasts as long as the watcher,
+// which is owned by the app the store belongs to.
+func watchIAVLFlushInterval(
+	watcher *settings.Watcher, s interface{ SetOnChainFlushInterval(int64) }, logger *loom.Logger,
+) error {
+	_, err := watcher.Subscribe(settings.AppStoreIAVLFlushInterval, func(value string) {
+		flushInterval, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			logger.Error("Invalid IAVL flush interval in on-chain config", "value", value, "err", err)
+			return
+		}
+		s.SetOnChainFlushInterval(flushInterval)
+	})
+	return err
+}
+
 func loadEvmStore(cfg *config.Config, targetVersion int64) (*store.EvmStore, error) {
 	evmStoreCfg := cfg.EvmStore
 	db, err := cdb.LoadDB(
@@ -726,7 +775,8 @@ func loadApp(
 ) (*loomchain.Application, error) {
 	logger := log.Root
 
-	appStore, err := loadAppStore(cfg, log.Default, appHeight)
+	settingsWatcher := settings.NewWatcher(settings.DefaultRegistry)
+	appStore, err := loadAppStore(cfg, log.Default, appHeight, settingsWatcher)
 
 	if err != nil {
 		return nil, err
@@ -861,6 +911,8 @@ func loadApp(
 			1: migrations.DPOSv3Migration,
 			2: migrations.GatewayMigration,
 			3: migrations.GatewayMigration,
+			4: migrations.AddressMapperIndexMigration,
+			5: migrations.CoinHolderIndexMigration,
 		},
 	}
 
@@ -1116,9 +1168,14 @@ func loadApp(
 	// as it doesn't pass control to other middlewares after it.
 	postCommitMiddlewares = append(postCommitMiddlewares, nonceTxHandler.PostCommitMiddleware())
 
+	if err := checkValidatorKeyActive(chainID, appStore, vmManager, b); err != nil {
+		return nil, err
+	}
+
 	return &loomchain.Application{
-		Store: appStore,
-		Init:  init,
+		Store:           appStore,
+		SettingsWatcher: settingsWatcher,
+		Init:            init,
 		TxHandler: loomchain.MiddlewareTxHandler(
 			txMiddleWare,
 			router,
@@ -1137,6 +1194,30 @@ func loadApp(
 	}, nil
 }
 
+// checkValidatorKeyActive returns an error if the node's validator key has been rotated to a new key
+// by the time the last block in the app store was committed, the node must be restarted with the
+// new priv validator once the key rotation takes effect.
+func checkValidatorKeyActive(
+	chainID string, appStore store.VersionedKVStore, vmManager *vm.Manager, b backend.Backend,
+) error {
+	state := loomchain.NewStoreState(
+		context.Background(), appStore, abci.Header{ChainID: chainID, Height: appStore.Version()}, nil, nil,
+	)
+	if !state.FeatureEnabled(features.DPOSVersion3_18, false) {
+		return nil
+	}
+
+	signer, err := b.NodeSigner()
+	if err != nil {
+		return err
+	}
+	dposV3Ctx, err := getContractStaticCtx("dposV3", vmManager)(state)
+	if err != nil {
+		return err
+	}
+	return dposv3.CheckValidatorKeyActive(dposV3Ctx, signer.PublicKey())
+}
+
 func deployContract(
 	state loomchain.State,
 	contractCfg config.ContractConfig,
@@ -1215,6 +1296,8 @@ func initBackend(cfg *config.Config, abciServerAddr string, fnRegistry fnConsens
 		RPCProxyPort:             cfg.RPCProxyPort,
 		CreateEmptyBlocks:        cfg.CreateEmptyBlocks,
 		HsmConfig:                cfg.HsmConfig,
+		RemoteSignerConfig:       cfg.RemoteSigner,
+		PrivValSafetyHeight:      cfg.PrivValSafetyHeight,
 		FnConsensusReactorConfig: cfg.FnConsensus.Reactor,
 		MempoolWalEnabled:        cfg.MempoolWalEnabled,
 	}
@@ -1332,6 +1415,7 @@ func main() {
 		newGenKeyCommand(),
 		newYubiHsmCommand(),
 		newNodeKeyCommand(),
+		newPrivValCommand(),
 		newStaticCallCommand(), //Depreciate
 		newGetBlocksByNumber(),
 		NewCoinCommand(),
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/loomnetwork/loomchain/cmd/loom/common"
+	"github.com/loomnetwork/loomchain/privval"
+)
+
+func newPrivValCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "privval",
+		Short: "Manage the priv validator's double-signing protection state",
+		Long: "The priv validator keeps track of the last height/round/step it signed to prevent the " +
+			"validator from double-signing. When a validator is moved to new hardware this state " +
+			"must be exported from the old node and imported on the new node, or PrivValSafetyHeight " +
+			"must be set in loom.yaml on the new node. The node must be stopped while running these commands.",
+	}
+	cmd.AddCommand(
+		newExportSignStateCommand(),
+		newImportSignStateCommand(),
+	)
+	return cmd
+}
+
+const exportSignStateCmdExample = `
+loom privval export-state
+loom privval export-state --out sign_state.json
+`
+
+func newExportSignStateCommand() *cobra.Command {
+	var outFile string
+	cmd := &cobra.Command{
+		Use:     "export-state",
+		Short:   "Export the priv validator's double-signing protection state",
+		Example: exportSignStateCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := common.ParseConfig()
+			if err != nil {
+				return err
+			}
+
+			backend := initBackend(cfg, "", nil)
+			state, err := backend.ExportSignState()
+			if err != nil {
+				return err
+			}
+
+			stateJSON, err := json.MarshalIndent(state, "", "  ")
+			if err != nil {
+				return err
+			}
+			if outFile == "" {
+				fmt.Println(string(stateJSON))
+				return nil
+			}
+			if err := ioutil.WriteFile(outFile, stateJSON, 0600); err != nil {
+				return err
+			}
+			fmt.Printf("Sign state at height %d exported to %s\n", state.LastHeight, outFile)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&outFile, "out", "", "File to write the state to (defaults to stdout)")
+	return cmd
+}
+
+const importSignStateCmdExample = `
+loom privval import-state sign_state.json
+`
+
+func newImportSignStateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-state [file]",
+		Short: "Import the priv validator's double-signing protection state",
+		Long: "Imports a double-signing protection state previously exported with export-state. " +
+			"The state must belong to the same validator key, and must not be behind the current state.",
+		Example: importSignStateCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := common.ParseConfig()
+			if err != nil {
+				return err
+			}
+
+			stateJSON, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var state privval.SignState
+			if err := json.Unmarshal(stateJSON, &state); err != nil {
+				return errors.Wrap(err, "failed to parse sign state")
+			}
+
+			backend := initBackend(cfg, "", nil)
+			imported, err := backend.ImportSignState(&state)
+			if err != nil {
+				return err
+			}
+			if imported {
+				fmt.Printf("Sign state at height %d imported\n", state.LastHeight)
+			} else {
+				fmt.Println("Current sign state is identical, nothing imported")
+			}
+			return nil
+		},
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/loomnetwork/loomchain/events"
 	"github.com/loomnetwork/loomchain/evm"
 	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
+	remotepv "github.com/loomnetwork/loomchain/privval/remote"
 	receipts "github.com/loomnetwork/loomchain/receipts/handler"
 	registry "github.com/loomnetwork/loomchain/registry/factory"
 	"github.com/loomnetwork/loomchain/rpc/eth"
@@ -115,6 +116,10 @@ type Config struct {
 	ContractLoaders []string
 	//Hsm
 	HsmConfig *hsmpv.HsmConfig
+	// Remote signer
+	RemoteSigner *remotepv.RemoteSignerConfig
+	// The priv validator won't sign any votes or proposals below this height
+	PrivValSafetyHeight int64
 
 	// Oracle serializable
 	// todo Cannot be read in from file due to nested pointers to structs.
@@ -248,6 +253,18 @@ type ChainConfigConfig struct {
 	LogLevel string
 	// Log destination for feature auto-enabler
 	LogDestination string
+	// Allow the node to halt itself before the chain activates a feature that isn't supported by
+	// the current build
+	UpgradeHaltEnabled bool
+	// Frequency (in seconds) with which the node should check for scheduled upgrades
+	UpgradeCheckInterval int64
+	// Number of blocks before the last block preceding the scheduled upgrade height at which the
+	// node should start logging warnings about the impending halt. The node always halts right
+	// after committing the block preceding the upgrade height.
+	UpgradeHaltMargin int64
+	// Path to the upgraded loom binary that should replace the current process when the node
+	// halts for an upgrade, if empty the node will just exit.
+	UpgradeBinaryPath string
 }
 
 type DeployerWhitelistConfig struct {
@@ -302,6 +319,10 @@ func DefaultChainConfigConfig(rpcProxyPort int32) *ChainConfigConfig {
 		DAppChainWriteURI:         fmt.Sprintf("http://127.0.0.1:%d/rpc", rpcProxyPort),
 		LogLevel:                  "info",
 		LogDestination:            "file://chainconfig.log",
+		UpgradeHaltEnabled:        false,
+		UpgradeCheckInterval:      10, // check for scheduled upgrades every 10 seconds
+		UpgradeHaltMargin:         10,
+		UpgradeBinaryPath:         "",
 	}
 }
 
@@ -448,6 +469,7 @@ func DefaultConfig() *Config {
 	cfg.PlasmaCash = plasmacfg.DefaultConfig()
 	cfg.AppStore = store.DefaultConfig()
 	cfg.HsmConfig = hsmpv.DefaultConfig()
+	cfg.RemoteSigner = remotepv.DefaultConfig()
 	cfg.TxLimiter = throttle.DefaultTxLimiterConfig()
 	cfg.ContractTxLimiter = throttle.DefaultContractTxLimiterConfig()
 	cfg.GoContractDeployerWhitelist = throttle.DefaultGoContractDeployerWhitelistConfig()
@@ -495,6 +517,7 @@ func (c *Config) Clone() *Config {
 	clone.PlasmaCash = c.PlasmaCash.Clone()
 	clone.AppStore = c.AppStore.Clone()
 	clone.HsmConfig = c.HsmConfig.Clone()
+	clone.RemoteSigner = c.RemoteSigner.Clone()
 	clone.TxLimiter = c.TxLimiter.Clone()
 	clone.ContractTxLimiter = c.ContractTxLimiter.Clone()
 	clone.EventStore = c.EventStore.Clone()
@@ -641,6 +664,17 @@ ChainConfig:
   LogLevel: {{ .ChainConfig.LogLevel }}
   # Log destination for feature auto-enabler
   LogDestination: {{ .ChainConfig.LogDestination }}
+  # Allow the node to halt itself before the chain activates a feature that isn't supported by
+  # the current build
+  UpgradeHaltEnabled: {{ .ChainConfig.UpgradeHaltEnabled }}
+  # Frequency (in seconds) with which the node should check for scheduled upgrades
+  UpgradeCheckInterval: {{ .ChainConfig.UpgradeCheckInterval }}
+  # Number of blocks before the halt at which the node should start logging warnings, the node
+  # always halts right after committing the block preceding the scheduled upgrade height
+  UpgradeHaltMargin: {{ .ChainConfig.UpgradeHaltMargin }}
+  # Path to the upgraded loom binary that should replace the current process when the node
+  # halts for an upgrade, if empty the node will just exit
+  UpgradeBinaryPath: "{{ .ChainConfig.UpgradeBinaryPath }}"
 
 #
 # DeployerWhitelist
@@ -715,21 +749,50 @@ PrometheusPushGateway:
 HsmConfig:
   # flag to enable HSM
   HsmEnabled: {{ .HsmConfig.HsmEnabled }}
-  # device type of HSM
+  # device type of HSM, yubihsm, or pkcs11 (softhsm) for any HSM with a PKCS#11 library
   HsmDevType: "{{ .HsmConfig.HsmDevType }}"
   # the path of PKCS#11 library
   HsmP11LibPath: "{{ .HsmConfig.HsmP11LibPath }}"
+  # label of the PKCS#11 token the sign key is stored on
+  HsmTokenLabel: "{{ .HsmConfig.HsmTokenLabel }}"
   # connection URL to YubiHSM
   HsmConnURL: {{ .HsmConfig.HsmConnURL }}
   # Auth key ID for YubiHSM
   HsmAuthKeyID: {{ .HsmConfig.HsmAuthKeyID }}
-  # Auth password
+  # Auth password for YubiHSM, or user PIN for PKCS#11
   HsmAuthPassword: "{{ .HsmConfig.HsmAuthPassword }}"
-  # Sign Key ID for YubiHSM
+  # Sign Key ID, a new key is generated if zero
   HsmSignKeyID: {{ .HsmConfig.HsmSignKeyID }}
   # key domain
   HsmSignKeyDomain: {{ .HsmConfig.HsmSignKeyDomain }}
 
+#
+# The priv validator won't sign any votes or proposals below this height, should be set when the
+# validator is moved to a new node, unless the sign state was exported from the old node and
+# imported on the new node with loom privval export-state & import-state.
+#
+PrivValSafetyHeight: {{ .PrivValSafetyHeight }}
+
+#
+# Remote signer
+#
+RemoteSigner:
+  # flag to enable the remote signer, the validator key is held by the remote signer instead of
+  # the node, takes precedence over the HSM config
+  Enabled: {{ .RemoteSigner.Enabled }}
+  # address of the remote signer, tcp://host:port or unix:///path/to/socket
+  Address: "{{ .RemoteSigner.Address }}"
+  # base64 encoded public key the remote signer authenticates itself with
+  SignerPubKey: "{{ .RemoteSigner.SignerPubKey }}"
+  # path to the key the node authenticates itself with, can be generated with loom-signer gen-identity
+  IdentityKeyFile: "{{ .RemoteSigner.IdentityKeyFile }}"
+  # number of seconds to wait for a connection to be established
+  DialTimeout: {{ .RemoteSigner.DialTimeout }}
+  # number of seconds to wait for the remote signer to respond to a request
+  ReadWriteTimeout: {{ .RemoteSigner.ReadWriteTimeout }}
+  # number of times to try connecting to the remote signer before giving up
+  MaxDialAttempts: {{ .RemoteSigner.MaxDialAttempts }}
+
 #
 # App store
 #
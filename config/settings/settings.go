@@ -0,0 +1,291 @@
+// Package settings contains the registry of on-chain config settings that can be changed via the
+// ChainConfig contract. Every setting has a type, bounds, an optional feature flag that must be
+// enabled before the setting can be changed, and a list of node components that subscribe to
+// changes to the setting via a Watcher. Settings that don't list any components are read from the
+// on-chain config whenever they're used, so changes to them take effect without a restart too.
+package settings
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	cctypes "github.com/loomnetwork/go-loom/builtin/types/chainconfig"
+	"github.com/loomnetwork/go-loom/config"
+	"github.com/pkg/errors"
+)
+
+// Names of the on-chain config settings.
+const (
+	AppStoreNumEvmKeysToPrune    = "AppStore.NumEvmKeysToPrune"
+	AppStorePruneEvmKeysInterval = "AppStore.PruneEvmKeysInterval"
+	AppStoreIAVLFlushInterval    = "AppStore.IAVLFlushInterval"
+	EvmGasLimit                  = "Evm.GasLimit"
+	NonceHandlerIncOnFailedTx    = "NonceHandler.IncNonceOnFailedTx"
+)
+
+var (
+	// ErrUnknownSetting is returned when a setting isn't in the registry.
+	ErrUnknownSetting = errors.New("unknown config setting")
+	// ErrInvalidValue is returned when a value can't be parsed, or is out of bounds.
+	ErrInvalidValue = errors.New("invalid config setting value")
+)
+
+// Kind identifies the type of value a setting holds.
+type Kind int
+
+const (
+	KindUint64 Kind = iota
+	KindBool
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindUint64:
+		return "uint64"
+	case KindBool:
+		return "bool"
+	}
+	return "unknown"
+}
+
+// Setting describes an on-chain config setting.
+type Setting struct {
+	// Fully qualified name of the setting, e.g. AppStore.IAVLFlushInterval
+	Name string
+	Kind Kind
+	// Inclusive bounds of a numeric setting, Max is ignored if it's zero.
+	Min uint64
+	Max uint64
+	// Feature flag that must be enabled before the setting can be changed, empty if the setting
+	// can be changed as soon as config changes are enabled on the chain.
+	Feature string
+	// Node components that subscribe to changes to this setting via a Watcher, empty if the setting
+	// is read from the on-chain config every time it's used.
+	Components []string
+	// Short description of what the setting controls.
+	Description string
+
+	get func(cfg *cctypes.Config) string
+}
+
+// Default returns the value the setting has before it's changed on-chain.
+func (s *Setting) Default() string {
+	return s.get(config.DefaultConfig())
+}
+
+// Value returns the value of the setting in the given config.
+func (s *Setting) Value(cfg *cctypes.Config) string {
+	return s.get(cfg)
+}
+
+// Validate checks that the given value can be assigned to the setting.
+func (s *Setting) Validate(value string) error {
+	switch s.Kind {
+	case KindUint64:
+		v, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidValue, "%s must be an unsigned integer", s.Name)
+		}
+		if v < s.Min || (s.Max != 0 && v > s.Max) {
+			return errors.Wrapf(ErrInvalidValue, "%s must be in range %s", s.Name, s.Bounds())
+		}
+	case KindBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.Wrapf(ErrInvalidValue, "%s must be true or false", s.Name)
+		}
+	}
+	return nil
+}
+
+// Bounds returns a human readable representation of the range of values the setting accepts.
+func (s *Setting) Bounds() string {
+	if s.Kind != KindUint64 {
+		return ""
+	}
+	if s.Max == 0 {
+		return fmt.Sprintf("[%d, ∞)", s.Min)
+	}
+	return fmt.Sprintf("[%d, %d]", s.Min, s.Max)
+}
+
+// Registry keeps track of the known settings, it's immutable once created so a single registry can
+// be shared by any number of apps.
+type Registry struct {
+	settings map[string]*Setting
+}
+
+// NewRegistry creates a registry containing the given settings.
+func NewRegistry(settings ...*Setting) *Registry {
+	r := &Registry{
+		settings: make(map[string]*Setting, len(settings)),
+	}
+	for _, s := range settings {
+		r.settings[s.Name] = s
+	}
+	return r
+}
+
+// Get looks up a setting by name.
+func (r *Registry) Get(name string) (*Setting, error) {
+	s, ok := r.settings[name]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownSetting, name)
+	}
+	return s, nil
+}
+
+// List returns all the settings in the registry, sorted by name.
+func (r *Registry) List() []*Setting {
+	list := make([]*Setting, 0, len(r.settings))
+	for _, s := range r.settings {
+		list = append(list, s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// Validate checks that the named setting exists and that the value can be assigned to it, the
+// featureEnabled func is used to check if the feature gating the setting has been enabled.
+func (r *Registry) Validate(name, value string, featureEnabled func(name string) bool) error {
+	s, err := r.Get(name)
+	if err != nil {
+		return err
+	}
+	if s.Feature != "" && (featureEnabled == nil || !featureEnabled(s.Feature)) {
+		return fmt.Errorf("config setting %s requires feature %s", name, s.Feature)
+	}
+	return s.Validate(value)
+}
+
+// Apply validates the value and assigns it to the named setting in the given config.
+func (r *Registry) Apply(cfg *cctypes.Config, name, value string, featureEnabled func(name string) bool) error {
+	if err := r.Validate(name, value, featureEnabled); err != nil {
+		return err
+	}
+	return config.SetConfigSetting(cfg, name, value)
+}
+
+// Watcher keeps track of the callbacks that should be invoked when the values of settings change.
+// Each app should create its own watcher, and notify it whenever the on-chain config is reloaded.
+type Watcher struct {
+	registry    *Registry
+	mutex       sync.Mutex
+	nextID      uint64
+	subscribers map[string]map[uint64]func(value string)
+	// last value each subscribed setting had when subscribers were notified
+	values map[string]string
+}
+
+// NewWatcher creates a watcher for the settings in the given registry.
+func NewWatcher(registry *Registry) *Watcher {
+	return &Watcher{
+		registry:    registry,
+		subscribers: make(map[string]map[uint64]func(value string)),
+		values:      make(map[string]string),
+	}
+}
+
+// Subscribe registers a callback that will be invoked with the current value of the named setting
+// the next time subscribers are notified, and then every time the value of the setting changes.
+// The returned func removes the callback, it should be called when the subscriber is disposed of.
+func (w *Watcher) Subscribe(name string, fn func(value string)) (unsubscribe func(), err error) {
+	if _, err := w.registry.Get(name); err != nil {
+		return nil, err
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	id := w.nextID
+	w.nextID++
+	if w.subscribers[name] == nil {
+		w.subscribers[name] = make(map[uint64]func(value string))
+	}
+	w.subscribers[name][id] = fn
+	delete(w.values, name)
+	return func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		delete(w.subscribers[name], id)
+		if len(w.subscribers[name]) == 0 {
+			delete(w.subscribers, name)
+			delete(w.values, name)
+		}
+	}, nil
+}
+
+// Notify invokes the callbacks of all the settings whose values in the given config differ from
+// the values subscribers were last notified of.
+func (w *Watcher) Notify(cfg *cctypes.Config) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for name, subscribers := range w.subscribers {
+		value := w.registry.settings[name].Value(cfg)
+		if prev, ok := w.values[name]; ok && prev == value {
+			continue
+		}
+		w.values[name] = value
+		for _, fn := range subscribers {
+			fn(value)
+		}
+	}
+}
+
+// DefaultRegistry contains all the settings supported by the current build.
+var DefaultRegistry = NewRegistry(
+	&Setting{
+		Name:        AppStoreNumEvmKeysToPrune,
+		Kind:        KindUint64,
+		Max:         100000,
+		Description: "Max number of EVM keys to prune from app.db per block",
+		get: func(cfg *cctypes.Config) string {
+			return strconv.FormatUint(cfg.GetAppStore().GetNumEvmKeysToPrune(), 10)
+		},
+	},
+	&Setting{
+		Name:        AppStorePruneEvmKeysInterval,
+		Kind:        KindUint64,
+		Description: "Number of blocks between EVM key pruning runs, zero to prune every block",
+		get: func(cfg *cctypes.Config) string {
+			return strconv.FormatUint(cfg.GetAppStore().GetPruneEvmKeysInterval(), 10)
+		},
+	},
+	&Setting{
+		Name:        AppStoreIAVLFlushInterval,
+		Kind:        KindUint64,
+		Max:         10000,
+		Components:  []string{"IAVLStore"},
+		Description: "Number of blocks between IAVL tree flushes to disk, zero to flush every block",
+		get: func(cfg *cctypes.Config) string {
+			return strconv.FormatUint(cfg.GetAppStore().GetIAVLFlushInterval(), 10)
+		},
+	},
+	&Setting{
+		Name:        EvmGasLimit,
+		Kind:        KindUint64,
+		Description: "Gas limit for EVM calls, zero to use the default limit",
+		get: func(cfg *cctypes.Config) string {
+			return strconv.FormatUint(cfg.GetEvm().GetGasLimit(), 10)
+		},
+	},
+	&Setting{
+		Name:        NonceHandlerIncOnFailedTx,
+		Kind:        KindBool,
+		Description: "Increment the caller nonce when a tx fails",
+		get: func(cfg *cctypes.Config) string {
+			return strconv.FormatBool(cfg.GetNonceHandler().GetIncNonceOnFailedTx())
+		},
+	},
+)
+
+// Get looks up a setting in the default registry.
+func Get(name string) (*Setting, error) {
+	return DefaultRegistry.Get(name)
+}
+
+// Validate checks a setting value against the default registry.
+func Validate(name, value string, featureEnabled func(name string) bool) error {
+	return DefaultRegistry.Validate(name, value, featureEnabled)
+}
@@ -0,0 +1,76 @@
+package settings
+
+import (
+	"strconv"
+	"testing"
+
+	cctypes "github.com/loomnetwork/go-loom/builtin/types/chainconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSetting(t *testing.T) {
+	allEnabled := func(name string) bool { return true }
+
+	require.NoError(t, Validate(AppStoreIAVLFlushInterval, "100", allEnabled))
+	require.Error(t, Validate(AppStoreIAVLFlushInterval, "100000", allEnabled))
+	require.Error(t, Validate(AppStoreIAVLFlushInterval, "-1", allEnabled))
+	require.Error(t, Validate(AppStoreIAVLFlushInterval, "abc", allEnabled))
+	require.NoError(t, Validate(NonceHandlerIncOnFailedTx, "true", allEnabled))
+	require.Error(t, Validate(NonceHandlerIncOnFailedTx, "10", allEnabled))
+	require.Error(t, Validate("AppStore.Unknown", "10", allEnabled))
+
+	r := NewRegistry(&Setting{
+		Name:    "Test.Gated",
+		Kind:    KindUint64,
+		Min:     1,
+		Feature: "test:v1.1",
+		get:     func(cfg *cctypes.Config) string { return "" },
+	})
+	require.Error(t, r.Validate("Test.Gated", "5", func(name string) bool { return false }))
+	require.Error(t, r.Validate("Test.Gated", "0", allEnabled))
+	require.NoError(t, r.Validate("Test.Gated", "5", allEnabled))
+}
+
+func TestApplySetting(t *testing.T) {
+	cfg := &cctypes.Config{}
+	require.NoError(t, DefaultRegistry.Apply(cfg, EvmGasLimit, "5000", nil))
+	require.Equal(t, uint64(5000), cfg.GetEvm().GetGasLimit())
+	require.Error(t, DefaultRegistry.Apply(cfg, AppStoreNumEvmKeysToPrune, "100001", nil))
+}
+
+func TestNotifySubscribers(t *testing.T) {
+	r := NewRegistry(&Setting{
+		Name: AppStoreIAVLFlushInterval,
+		Kind: KindUint64,
+		get: func(cfg *cctypes.Config) string {
+			return strconv.FormatUint(cfg.GetAppStore().GetIAVLFlushInterval(), 10)
+		},
+	})
+	w := NewWatcher(r)
+	var values []string
+	unsubscribe, err := w.Subscribe(AppStoreIAVLFlushInterval, func(value string) {
+		values = append(values, value)
+	})
+	require.NoError(t, err)
+	_, err = w.Subscribe(EvmGasLimit, func(value string) {})
+	require.Error(t, err)
+
+	cfg := &cctypes.Config{AppStore: &cctypes.AppStoreConfig{IAVLFlushInterval: 10}}
+	// subscribers are always notified of the initial value
+	w.Notify(cfg)
+	require.Equal(t, []string{"10"}, values)
+	// but not notified again until the value changes
+	w.Notify(cfg)
+	require.Equal(t, []string{"10"}, values)
+	cfg.AppStore.IAVLFlushInterval = 20
+	w.Notify(cfg)
+	require.Equal(t, []string{"10", "20"}, values)
+	// watchers don't share subscribers
+	NewWatcher(r).Notify(&cctypes.Config{AppStore: &cctypes.AppStoreConfig{IAVLFlushInterval: 30}})
+	require.Equal(t, []string{"10", "20"}, values)
+	// and unsubscribed callbacks are no longer invoked
+	unsubscribe()
+	cfg.AppStore.IAVLFlushInterval = 40
+	w.Notify(cfg)
+	require.Equal(t, []string{"10", "20"}, values)
+}
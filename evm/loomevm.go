@@ -175,7 +175,7 @@ func (lvm LoomVm) Create(caller loom.Address, code []byte, value *loom.BigUInt)
 				val = value.Int
 			}
 			ethTxHash := types.NewContractCreation(
-				uint64(auth.Nonce(lvm.state, caller)), val, math.MaxUint64, big.NewInt(0), code,
+				auth.TxNonce(lvm.state, caller), val, math.MaxUint64, big.NewInt(0), code,
 			).Hash().Bytes()
 
 			if lvm.state.FeatureEnabled(features.EvmTxReceiptsVersion3_3, false) {
@@ -238,7 +238,7 @@ func (lvm LoomVm) Call(caller, addr loom.Address, input []byte, value *loom.BigU
 				val = value.Int
 			}
 			ethTxHash := types.NewTransaction(
-				uint64(auth.Nonce(lvm.state, caller)), common.BytesToAddress(addr.Local),
+				auth.TxNonce(lvm.state, caller), common.BytesToAddress(addr.Local),
 				val, math.MaxUint64, big.NewInt(0), input,
 			).Hash().Bytes()
 
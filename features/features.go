@@ -30,6 +30,15 @@ const (
 
 	// Enables support for mapping DAppChain accounts to Binance accounts
 	AddressMapperVersion1_1 = "addrmapper:v1.1"
+	// Enables removal & rebinding of identity mappings in the Address Mapper contract
+	AddressMapperVersion1_2 = "addrmapper:v1.2"
+	// Enables the Address Mapper mapping index used for paginated mapping queries, existing mappings
+	// must be indexed via migration 4.
+	AddressMapperVersion1_3 = "addrmapper:v1.3"
+	// Enables mapping DAppChain accounts to secp256r1 (WebAuthn) & Cosmos accounts
+	AddressMapperVersion1_4 = "addrmapper:v1.4"
+	// Enables session keys in the Address Mapper contract
+	AddressMapperVersion1_5 = "addrmapper:v1.5"
 
 	// Enables processing of txs via MultiChainSignatureTxMiddleware, there's a feature flag per
 	// allowed chain ID, e.g. auth:sigtx:default, auth:sigtx:eth
@@ -37,6 +46,9 @@ const (
 
 	// Enables stricter chain-specific signature verification in MultiChainSignatureTxMiddleware
 	MultiChainSigTxMiddlewareVersion1_1 = "mw:mulcsigtx:v1.1"
+	// Enables resolution of session key signers to the accounts that authorized them in the
+	// MultiChainSignatureTxMiddleware
+	MultiChainSigTxMiddlewareVersion1_2 = "mw:mulcsigtx:v1.2"
 
 	// Enables DPOS v3
 	// NOTE: The DPOS v3 contract must be loaded & deployed first!
@@ -61,6 +73,22 @@ const (
 	DPOSVersion3_9 = "dpos:v3.9"
 	// Makes it possible for the oracle to call Redelegate & UnregisterCandidate
 	DPOSVersion3_10 = "dpos:v3.10"
+	// Enables liquid staking via transferable stake receipts
+	DPOSVersion3_11 = "dpos:v3.11"
+	// Enables auto-compounding of delegation rewards
+	DPOSVersion3_12 = "dpos:v3.12"
+	// Enables slashing & jailing of validators that double-sign blocks
+	DPOSVersion3_13 = "dpos:v3.13"
+	// Enables candidate fee limits & gradual fee changes
+	DPOSVersion3_14 = "dpos:v3.14"
+	// Enables recording of per-election reward & delegation history
+	DPOSVersion3_15 = "dpos:v3.15"
+	// Enables redelegation cooldowns & per-election redelegation limits
+	DPOSVersion3_16 = "dpos:v3.16"
+	// Enables stake-weighted governance proposals for changing DPOS parameters
+	DPOSVersion3_17 = "dpos:v3.17"
+	// Enables rotation of validator signing keys
+	DPOSVersion3_18 = "dpos:v3.18"
 
 	// Enables rewards to be distributed even when a delegator owns less than 0.01% of the validator's stake
 	// Also makes whitelists give bonuses correctly if whitelist locktime tier is set to be 0-3 (else defaults to 5%)
@@ -123,9 +151,23 @@ const (
 	// Enables checking of minimum required build number on node startup.
 	ChainCfgVersion1_4 = "chaincfg:v1.4"
 
+	// Enables validation of config settings against the settings registry in the ChainConfig
+	// contract, and when config changes are applied to the on-chain config.
+	ChainCfgVersion1_5 = "chaincfg:v1.5"
+
 	// Enables the EthTxHandler for processing signed RLP endoed Ethereum txs.
 	EthTxFeature = "tx:eth"
 
+	// Enables processing of relayed txs, i.e. txs signed by a user & submitted by a relayer that
+	// pays for them.
+	RelayedTxFeature = "tx:relayed"
+
+	// Enables nonce lanes, i.e. multiple independent nonce sequences per account.
+	NonceLanesFeature = "nonce:lanes"
+
+	// Enables expiry of txs (by block height or time) via ExpiringNonceTx.
+	TxExpiryFeature = "tx:expiry"
+
 	// Forces the MultiWriterAppStore to write EVM state only to evm.db, otherwise it'll write EVM
 	// state to both evm.db & app.db.
 	EvmDBFeature = "db:evm"
@@ -136,6 +178,12 @@ const (
 	CoinVersion1_2Feature = "coin:v1.2"
 	// Enables minting & burning via Binance Gateway
 	CoinVersion1_3Feature = "coin:v1.3"
+	// Enables signed approvals (permits) in the Coin & ETH Coin contracts
+	CoinVersion1_4Feature = "coin:v1.4"
+	// Enables the holder indexes & transfer history in the Coin & ETH Coin contracts
+	CoinVersion1_5Feature = "coin:v1.5"
+	// Enables batch transfers in the Coin & ETH Coin contracts
+	CoinVersion1_6Feature = "coin:v1.6"
 
 	// Force ReceiptHandler to write BloomFilter and EVM TxHash only to receipts_db, otherwise it'll
 	// write BloomFilter and EVM TxHash to both receipts_db & app.db.
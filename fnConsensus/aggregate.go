@@ -0,0 +1,61 @@
+package fnConsensus
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/loomnetwork/loomchain/fnConsensus/bls"
+)
+
+// aggregateSignatures verifies the BLS signatures of the message produced by the validators that
+// voted for it, and combines the valid signatures into a single signature. The signatures must be
+// ordered in the same way as the validators in the validator set, with nil entries for validators
+// that didn't vote for the message. Returns the aggregate signature, and the public keys of the
+// validators whose signatures were aggregated.
+func aggregateSignatures(
+	fn AggregateSignatureFn, message []byte, signatures [][]byte, validators *types.ValidatorSet,
+	logger log.Logger,
+) ([]byte, [][]byte, error) {
+	if len(signatures) != validators.Size() {
+		return nil, nil, fmt.Errorf(
+			"number of signatures %d doesn't match validator set size %d", len(signatures), validators.Size(),
+		)
+	}
+
+	sigs := make([]*bls.Signature, 0, len(signatures))
+	signerPubKeys := make([][]byte, 0, len(signatures))
+	for i, sigBytes := range signatures {
+		if sigBytes == nil {
+			continue
+		}
+		_, validator := validators.GetByIndex(i)
+		pubKeyBytes, err := fn.GetBLSPublicKey(validator.Address)
+		if err != nil {
+			return nil, nil, err
+		}
+		if pubKeyBytes == nil {
+			logger.Info("Excluding signature of validator with unknown BLS public key", "validator", validator.Address)
+			continue
+		}
+		pubKey, err := bls.UnmarshalPublicKey(pubKeyBytes)
+		if err != nil {
+			logger.Error("Excluding signature of validator with invalid BLS public key", "validator", validator.Address)
+			continue
+		}
+		sig, err := bls.UnmarshalSignature(sigBytes)
+		if err != nil || !bls.Verify(pubKey, message, sig) {
+			logger.Error("Excluding invalid BLS signature", "validator", validator.Address)
+			continue
+		}
+		sigs = append(sigs, sig)
+		signerPubKeys = append(signerPubKeys, pubKeyBytes)
+	}
+
+	aggSig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aggSig.Marshal(), signerPubKeys, nil
+}
@@ -0,0 +1,89 @@
+package fnConsensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/loomnetwork/loomchain/fnConsensus/bls"
+)
+
+type testAggregateSignatureFn struct {
+	blsPubKeys map[string][]byte
+}
+
+func (fn *testAggregateSignatureFn) GetMessageAndSignature(ctx []byte) ([]byte, []byte, error) {
+	return nil, nil, nil
+}
+
+func (fn *testAggregateSignatureFn) SubmitMultiSignedMessage(ctx []byte, key []byte, signatures [][]byte) {
+}
+
+func (fn *testAggregateSignatureFn) GetBLSPublicKey(validatorAddress []byte) ([]byte, error) {
+	return fn.blsPubKeys[string(validatorAddress)], nil
+}
+
+func (fn *testAggregateSignatureFn) SubmitAggregateSignedMessage(
+	ctx []byte, key []byte, aggregateSignature []byte, signerPubKeys [][]byte,
+) {
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	numValidators := 5
+	validators := make([]*types.Validator, numValidators)
+	for i := range validators {
+		validators[i] = types.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+	}
+	valSet := types.NewValidatorSet(validators)
+
+	message := []byte("withdrawal hash")
+	fn := &testAggregateSignatureFn{blsPubKeys: map[string][]byte{}}
+	signatures := make([][]byte, numValidators)
+	var expectedSigners []*bls.PublicKey
+	for i := 0; i < numValidators; i++ {
+		_, validator := valSet.GetByIndex(i)
+		sk, err := bls.GenerateKey(nil)
+		require.NoError(t, err)
+
+		switch i {
+		case 0, 1:
+			// valid signatures
+			fn.blsPubKeys[string(validator.Address)] = sk.PublicKey().Marshal()
+			signatures[i] = sk.Sign(message).Marshal()
+			expectedSigners = append(expectedSigners, sk.PublicKey())
+		case 2:
+			// didn't vote
+			fn.blsPubKeys[string(validator.Address)] = sk.PublicKey().Marshal()
+		case 3:
+			// signed the wrong message
+			fn.blsPubKeys[string(validator.Address)] = sk.PublicKey().Marshal()
+			signatures[i] = sk.Sign([]byte("another message")).Marshal()
+		case 4:
+			// unknown BLS key
+			signatures[i] = sk.Sign(message).Marshal()
+		}
+	}
+
+	aggSigBytes, signerPubKeys, err := aggregateSignatures(fn, message, signatures, valSet, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, signerPubKeys, len(expectedSigners))
+	for i, pubKey := range expectedSigners {
+		require.Equal(t, pubKey.Marshal(), signerPubKeys[i])
+	}
+
+	aggSig, err := bls.UnmarshalSignature(aggSigBytes)
+	require.NoError(t, err)
+	require.True(t, bls.VerifyAggregate(expectedSigners, message, aggSig))
+
+	// there must be at least one valid signature
+	_, _, err = aggregateSignatures(
+		fn, message, make([][]byte, numValidators), valSet, log.NewNopLogger(),
+	)
+	require.Error(t, err)
+
+	_, _, err = aggregateSignatures(fn, message, signatures[1:], valSet, log.NewNopLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,229 @@
+// Package bls implements BLS signatures over the alt_bn128 (BN254) curve, which can be verified
+// cheaply on Ethereum via the ecAdd, ecMul & ecPairing precompiles.
+//
+// Signatures are points on G1 (64 bytes), and public keys are points on G2 (128 bytes). Since all
+// the validators sign the same message the signatures can be aggregated by adding them together,
+// and the aggregate signature can be verified against the sum of the signers' public keys.
+// To prevent rogue key attacks public keys must only be accepted along with a proof of possession
+// of the corresponding secret key, see ProvePossession & VerifyPossession.
+package bls
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	bn256 "github.com/ethereum/go-ethereum/crypto/bn256/google"
+)
+
+const (
+	SecretKeySize = 32
+	PublicKeySize = 128
+	SignatureSize = 64
+)
+
+var (
+	// field modulus of alt_bn128
+	fieldModulus, _ = new(big.Int).SetString(
+		"21888242871839275222246405745257275088696311157297823662689037025051135744583", 10,
+	)
+	// order of the alt_bn128 groups
+	groupOrder, _ = new(big.Int).SetString(
+		"21888242871839275222246405745257275088548364400416034343698204186575808495617", 10,
+	)
+	curveB = big.NewInt(3)
+
+	g2Generator = new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+
+	// domain separation tag for proofs of possession, so that a proof of possession can't be used
+	// as a signature for a message that happens to be the same as the public key
+	possessionDST = []byte("loomchain:bls:pop")
+)
+
+var (
+	ErrInvalidSecretKey = errors.New("invalid BLS secret key")
+	ErrInvalidPublicKey = errors.New("invalid BLS public key")
+	ErrInvalidSignature = errors.New("invalid BLS signature")
+)
+
+type SecretKey struct {
+	x *big.Int
+}
+
+type PublicKey struct {
+	p *bn256.G2
+}
+
+type Signature struct {
+	p *bn256.G1
+}
+
+// GenerateKey generates a new key pair, if r is nil crypto/rand is used.
+func GenerateKey(r io.Reader) (*SecretKey, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+	for {
+		x, err := rand.Int(r, groupOrder)
+		if err != nil {
+			return nil, err
+		}
+		if x.Sign() > 0 {
+			return &SecretKey{x: x}, nil
+		}
+	}
+}
+
+// UnmarshalSecretKey decodes a big-endian encoded secret key.
+func UnmarshalSecretKey(b []byte) (*SecretKey, error) {
+	if len(b) != SecretKeySize {
+		return nil, ErrInvalidSecretKey
+	}
+	x := new(big.Int).SetBytes(b)
+	if x.Sign() == 0 || x.Cmp(groupOrder) >= 0 {
+		return nil, ErrInvalidSecretKey
+	}
+	return &SecretKey{x: x}, nil
+}
+
+// Marshal returns the big-endian encoding of the secret key.
+func (sk *SecretKey) Marshal() []byte {
+	b := make([]byte, SecretKeySize)
+	xBytes := sk.x.Bytes()
+	copy(b[SecretKeySize-len(xBytes):], xBytes)
+	return b
+}
+
+func (sk *SecretKey) PublicKey() *PublicKey {
+	return &PublicKey{p: new(bn256.G2).ScalarBaseMult(sk.x)}
+}
+
+// Sign signs the given message.
+func (sk *SecretKey) Sign(msg []byte) *Signature {
+	return &Signature{p: new(bn256.G1).ScalarMult(HashToG1(msg), sk.x)}
+}
+
+// ProvePossession returns a proof that the owner of the public key has the corresponding
+// secret key.
+func (sk *SecretKey) ProvePossession() *Signature {
+	return sk.Sign(possessionMsg(sk.PublicKey()))
+}
+
+// UnmarshalPublicKey decodes a public key encoded in the format used by the Ethereum precompiles.
+func UnmarshalPublicKey(b []byte) (*PublicKey, error) {
+	if len(b) != PublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+	p := new(bn256.G2)
+	if _, err := p.Unmarshal(b); err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// Marshal encodes the public key in the format used by the Ethereum precompiles.
+func (pk *PublicKey) Marshal() []byte {
+	return pk.p.Marshal()
+}
+
+// UnmarshalSignature decodes a signature encoded in the format used by the Ethereum precompiles.
+func UnmarshalSignature(b []byte) (*Signature, error) {
+	if len(b) != SignatureSize {
+		return nil, ErrInvalidSignature
+	}
+	p := new(bn256.G1)
+	if _, err := p.Unmarshal(b); err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return &Signature{p: p}, nil
+}
+
+// Marshal encodes the signature in the format used by the Ethereum precompiles.
+func (sig *Signature) Marshal() []byte {
+	return sig.p.Marshal()
+}
+
+// Verify checks that the signature was produced by signing the message with the secret key
+// corresponding to the given public key.
+func Verify(pk *PublicKey, msg []byte, sig *Signature) bool {
+	// e(sig, g2) == e(H(m), pk)
+	return bn256.PairingCheck(
+		[]*bn256.G1{sig.p, new(bn256.G1).Neg(HashToG1(msg))},
+		[]*bn256.G2{g2Generator, pk.p},
+	)
+}
+
+// VerifyPossession checks the proof of possession of a public key.
+func VerifyPossession(pk *PublicKey, proof *Signature) bool {
+	return Verify(pk, possessionMsg(pk), proof)
+}
+
+// AggregateSignatures combines signatures of the same message into a single signature.
+func AggregateSignatures(sigs []*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	p := sigs[0].p
+	for _, sig := range sigs[1:] {
+		p = new(bn256.G1).Add(p, sig.p)
+	}
+	return &Signature{p: p}, nil
+}
+
+// AggregatePublicKeys combines public keys into a single public key that can be used to verify
+// an aggregate signature produced by the corresponding secret keys.
+func AggregatePublicKeys(pks []*PublicKey) (*PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, errors.New("no public keys to aggregate")
+	}
+	p := pks[0].p
+	for _, pk := range pks[1:] {
+		p = new(bn256.G2).Add(p, pk.p)
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// VerifyAggregate checks that an aggregate signature was produced by signing the message with the
+// secret keys corresponding to all the given public keys.
+func VerifyAggregate(pks []*PublicKey, msg []byte, sig *Signature) bool {
+	pk, err := AggregatePublicKeys(pks)
+	if err != nil {
+		return false
+	}
+	return Verify(pk, msg, sig)
+}
+
+// HashToG1 maps a message to a point on G1 using the try-and-increment method, starting with
+// x = keccak256(msg) mod p and incrementing x until x^3 + 3 is a quadratic residue. The same
+// mapping can be implemented in Solidity to verify signatures on Ethereum.
+func HashToG1(msg []byte) *bn256.G1 {
+	x := new(big.Int).SetBytes(crypto.Keccak256(msg))
+	x.Mod(x, fieldModulus)
+	one := big.NewInt(1)
+	for {
+		// y^2 = x^3 + 3
+		y2 := new(big.Int).Exp(x, big.NewInt(3), fieldModulus)
+		y2.Add(y2, curveB)
+		y2.Mod(y2, fieldModulus)
+		if y := new(big.Int).ModSqrt(y2, fieldModulus); y != nil {
+			b := make([]byte, 64)
+			xBytes, yBytes := x.Bytes(), y.Bytes()
+			copy(b[32-len(xBytes):32], xBytes)
+			copy(b[64-len(yBytes):], yBytes)
+			p := new(bn256.G1)
+			if _, err := p.Unmarshal(b); err != nil {
+				// can't happen since (x, y) is on the curve
+				panic(err)
+			}
+			return p
+		}
+		x.Add(x, one)
+		x.Mod(x, fieldModulus)
+	}
+}
+
+func possessionMsg(pk *PublicKey) []byte {
+	return append(append([]byte{}, possessionDST...), pk.Marshal()...)
+}
@@ -0,0 +1,91 @@
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	sk, err := GenerateKey(nil)
+	require.NoError(t, err)
+	pk := sk.PublicKey()
+	msg := []byte("withdrawal hash")
+
+	sig := sk.Sign(msg)
+	require.True(t, Verify(pk, msg, sig))
+	require.False(t, Verify(pk, []byte("another message"), sig))
+
+	otherSK, err := GenerateKey(nil)
+	require.NoError(t, err)
+	require.False(t, Verify(otherSK.PublicKey(), msg, sig))
+}
+
+func TestMarshalling(t *testing.T) {
+	sk, err := GenerateKey(nil)
+	require.NoError(t, err)
+	msg := []byte("withdrawal hash")
+
+	sk2, err := UnmarshalSecretKey(sk.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, sk.Marshal(), sk2.Marshal())
+
+	pkBytes := sk.PublicKey().Marshal()
+	require.Len(t, pkBytes, PublicKeySize)
+	pk, err := UnmarshalPublicKey(pkBytes)
+	require.NoError(t, err)
+
+	sigBytes := sk.Sign(msg).Marshal()
+	require.Len(t, sigBytes, SignatureSize)
+	sig, err := UnmarshalSignature(sigBytes)
+	require.NoError(t, err)
+	require.True(t, Verify(pk, msg, sig))
+
+	_, err = UnmarshalSignature(sigBytes[1:])
+	require.Error(t, err)
+	// not a point on the curve
+	badSig := append([]byte{}, sigBytes...)
+	badSig[63] ^= 0x01
+	_, err = UnmarshalSignature(badSig)
+	require.Error(t, err)
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	msg := []byte("withdrawal hash")
+	var pks []*PublicKey
+	var sigs []*Signature
+	for i := 0; i < 5; i++ {
+		sk, err := GenerateKey(nil)
+		require.NoError(t, err)
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sk.Sign(msg))
+	}
+
+	aggSig, err := AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, VerifyAggregate(pks, msg, aggSig))
+
+	// the aggregate must be verified against the keys of all the signers
+	require.False(t, VerifyAggregate(pks[1:], msg, aggSig))
+
+	aggSig, err = AggregateSignatures(sigs[:3])
+	require.NoError(t, err)
+	require.True(t, VerifyAggregate(pks[:3], msg, aggSig))
+	require.False(t, VerifyAggregate(pks, msg, aggSig))
+
+	_, err = AggregateSignatures(nil)
+	require.Error(t, err)
+}
+
+func TestProofOfPossession(t *testing.T) {
+	sk, err := GenerateKey(nil)
+	require.NoError(t, err)
+	otherSK, err := GenerateKey(nil)
+	require.NoError(t, err)
+
+	proof := sk.ProvePossession()
+	require.True(t, VerifyPossession(sk.PublicKey(), proof))
+	require.False(t, VerifyPossession(otherSK.PublicKey(), proof))
+	// a signature of the public key isn't a valid proof
+	require.False(t, VerifyPossession(sk.PublicKey(), sk.Sign(sk.PublicKey().Marshal())))
+}
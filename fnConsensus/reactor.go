@@ -127,14 +127,25 @@ func NewFnConsensusReactor(
 	return reactor, nil
 }
 
-func (f *FnConsensusReactor) safeSubmitMultiSignedMessage(fnID string, fn Fn, message []byte, signatures [][]byte) {
+func (f *FnConsensusReactor) safeSubmitMultiSignedMessage(
+	fnID string, fn Fn, message []byte, signatures [][]byte, validators *types.ValidatorSet,
+) {
 	defer func() {
 		err := recover()
 		if err != nil {
 			f.Logger.Error("panicked while invoking SubmitMultiSignedMessage", "error", err)
 		}
 	}()
-	fn.SubmitMultiSignedMessage(nil, message, signatures)
+	if aggFn, ok := fn.(AggregateSignatureFn); ok {
+		aggSig, signerPubKeys, err := aggregateSignatures(aggFn, message, signatures, validators, f.Logger)
+		if err != nil {
+			f.Logger.Error("failed to aggregate signatures", "fnID", fnID, "err", err)
+			return
+		}
+		aggFn.SubmitAggregateSignedMessage(nil, message, aggSig, signerPubKeys)
+	} else {
+		fn.SubmitMultiSignedMessage(nil, message, signatures)
+	}
 	submittedMessageCount.With("fnID", fnID).Add(1)
 }
 
@@ -510,6 +521,7 @@ func (f *FnConsensusReactor) vote(fnID string, fn Fn, currentValidators *types.V
 			fn,
 			safeCopyBytes(f.state.Messages[fnID].Payload),
 			safeCopyDoubleArray(aggregateExecutionResponse.OracleSignatures),
+			currentValidators,
 		)
 		return
 	}
@@ -645,6 +657,7 @@ func (f *FnConsensusReactor) commit(fnID string) {
 						fn,
 						safeCopyBytes(f.state.Messages[fnID].Payload),
 						safeCopyDoubleArray(majExecutionResponse.OracleSignatures),
+						currentValidators,
 					)
 				}
 			}
@@ -25,6 +25,24 @@ type Fn interface {
 	SubmitMultiSignedMessage(ctx []byte, key []byte, signatures [][]byte)
 }
 
+// AggregateSignatureFn is an optional interface that can be implemented by a Fn whose
+// GetMessageAndSignature method returns BLS signatures (see the fnConsensus/bls package).
+// Instead of passing the individual signatures to SubmitMultiSignedMessage the reactor will verify
+// them, aggregate the valid ones into a single signature, and pass that to
+// SubmitAggregateSignedMessage, which means only a single compact signature has to be submitted
+// to (and verified by) the target chain.
+type AggregateSignatureFn interface {
+	Fn
+	// Returns the BLS public key the validator with the given address signs messages with, or nil
+	// if the key is unknown. Signatures from validators with unknown keys are excluded from the
+	// aggregate signature.
+	GetBLSPublicKey(validatorAddress []byte) ([]byte, error)
+	// Once the reactor reaches the vote threshold for the message identified by the given key
+	// it invokes this method (instead of SubmitMultiSignedMessage) with the aggregate signature of the
+	// validators that participated in the vote, and the BLS public keys of those validators.
+	SubmitAggregateSignedMessage(ctx []byte, key []byte, aggregateSignature []byte, signerPubKeys [][]byte)
+}
+
 // FnRegistry acts as a registry which stores multiple Fn objects by their IDs
 // And allows reactor to query Fns at time of propose and validation.
 type FnRegistry interface {
@@ -0,0 +1,25 @@
+// +build evm
+
+package migrations
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/loomnetwork/loomchain/builtin/plugins/address_mapper"
+	"github.com/loomnetwork/loomchain/features"
+)
+
+// AddressMapperIndexMigration adds all the mappings that were created before addrmapper:v1.3 was
+// enabled to the Address Mapper mapping index. The feature must be enabled before the migration
+// runs, otherwise mappings created between the migration and the activation of the feature
+// wouldn't be indexed.
+func AddressMapperIndexMigration(ctx *MigrationContext, parameters []byte) error {
+	mapperCtx, err := ctx.ContractContext("addressmapper")
+	if err != nil {
+		return err
+	}
+	if !mapperCtx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		return errors.Errorf("%s feature must be enabled before the mapping index is built", features.AddressMapperVersion1_3)
+	}
+	return address_mapper.BuildMappingIndex(mapperCtx)
+}
@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
+	"github.com/loomnetwork/loomchain/registry"
+)
+
+// CoinHolderIndexMigration adds all the accounts that were created before coin:v1.5 was enabled to
+// the holder indexes of the Coin & ETHCoin contracts, contracts that aren't deployed are skipped.
+func CoinHolderIndexMigration(ctx *MigrationContext, parameters []byte) error {
+	for _, contractName := range []string{"coin", "ethcoin"} {
+		coinCtx, err := ctx.ContractContext(contractName)
+		if err == registry.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := coin.BuildHolderIndex(coinCtx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+// +build !evm
+
+package migrations
+
+import "github.com/pkg/errors"
+
+// AddressMapperIndexMigration is a placeholder used in non-EVM builds.
+func AddressMapperIndexMigration(ctx *MigrationContext, parameters []byte) error {
+	return errors.New("This is not implemented")
+}
@@ -82,6 +82,7 @@ func (m *ValidatorsManagerV3) BeginBlock(req abci.RequestBeginBlock, currentHeig
 		}
 	}
 
+	byzantineSlashingEnabled := m.ctx.FeatureEnabled(features.DPOSVersion3_13, false)
 	for _, evidence := range req.ByzantineValidators {
 		// DuplicateVoteEvidence is the only type of evidence currently
 		// implemented in tendermint but we don't get access to this via the
@@ -91,10 +92,23 @@ func (m *ValidatorsManagerV3) BeginBlock(req abci.RequestBeginBlock, currentHeig
 
 		if evidence.Height > (currentHeight - 100) {
 			m.ctx.Logger().Debug("DPOS BeginBlock Byzantine Slashing", "FreshEvidenceHeight", evidence.Height, "CurrentHeight", currentHeight)
-			//err := m.SlashDoubleSign(evidence.Validator.Address)
-			//if err != nil {
-			//	return err
-			//}
+			if !byzantineSlashingEnabled {
+				continue
+			}
+			address, err := dposv3.GetLocalCandidateAddressFromTendermintAddress(
+				m.ctx, evidence.Validator.Address, candidates,
+			)
+			if err != nil {
+				m.ctx.Logger().Error(
+					"DPOS BeginBlock failed to resolve byzantine validator",
+					"validator", fmt.Sprintf("%X", evidence.Validator.Address),
+					"err", err,
+				)
+				continue
+			}
+			if err := dposv3.SlashByzantineValidator(m.ctx, address, evidence.Height); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -136,7 +150,8 @@ func (m *ValidatorsManagerV3) EndBlock(req abci.RequestEndBlock) ([]abci.Validat
 	var validators []abci.ValidatorUpdate
 
 	// Clearing current validators by passing in list of zero-power update to
-	// tendermint.
+	// tendermint. This also removes the old keys of validators whose keys were
+	// rotated in this election, since those keys are no longer in the list.
 	removedValidators := dposv3.MissingValidators(oldValidatorList, validatorList)
 	for _, validator := range removedValidators {
 		validators = append(validators, abci.ValidatorUpdate{
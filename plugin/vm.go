@@ -159,6 +159,7 @@ func CreateAddress(parent loom.Address, nonce uint64) loom.Address {
 }
 
 func (vm *PluginVM) Create(caller loom.Address, code []byte, value *loom.BigUInt) ([]byte, loom.Address, error) {
+	// Deploy txs can only be sent in nonce lane zero, so the account nonce is unique per deployment.
 	nonce := auth.Nonce(vm.State, caller)
 	contractAddr := CreateAddress(caller, nonce)
 
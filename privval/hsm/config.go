@@ -2,9 +2,10 @@ package hsmpv
 
 // HSM device types
 const (
-	HsmDevTypeSoft  = "softhsm"
-	HsmDevTypeYubi  = "yubihsm"
-	HsmDevTypeCloud = "cloudhsm"
+	HsmDevTypeSoft   = "softhsm"
+	HsmDevTypeYubi   = "yubihsm"
+	HsmDevTypeCloud  = "cloudhsm"
+	HsmDevTypePkcs11 = "pkcs11"
 )
 
 // HsmConfig implements configurations for HSM device
@@ -18,6 +19,9 @@ type HsmConfig struct {
 	// the path of PKCS#11 library
 	HsmP11LibPath string
 
+	// label of the PKCS#11 token the sign key is stored on
+	HsmTokenLabel string
+
 	// connection URL to YubiHSM
 	HsmConnURL string
 
@@ -40,6 +44,7 @@ func DefaultConfig() *HsmConfig {
 		HsmEnabled:       false,
 		HsmDevType:       "yubihsm",
 		HsmP11LibPath:    "",
+		HsmTokenLabel:    "",
 		HsmConnURL:       "http://localhost:12345",
 		HsmAuthKeyID:     1,
 		HsmAuthPassword:  "password",
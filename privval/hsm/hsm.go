@@ -42,14 +42,9 @@ type HsmPrivVal interface {
 
 // GenHsmPV generates priv validator with ed25519 keypair
 func GenHsmPV(hsmConfig *HsmConfig, filePath string) (HsmPrivVal, error) {
-	var pv HsmPrivVal
-	var err error
-
-	// load configuration
-	if hsmConfig.HsmDevType == HsmDevTypeYubi {
-		pv = NewYubiHsmPrivVal(hsmConfig)
-	} else {
-		return nil, errors.New("Unsupported HSM type")
+	pv, err := newHsmPV(hsmConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	if err = pv.GenPrivVal(filePath); err != nil {
@@ -61,13 +56,9 @@ func GenHsmPV(hsmConfig *HsmConfig, filePath string) (HsmPrivVal, error) {
 
 // LoadHsmPV loads parameters from priv validator file
 func LoadHsmPV(hsmConfig *HsmConfig, filePath string) (HsmPrivVal, error) {
-	var pv HsmPrivVal
-
-	// load configuration
-	if hsmConfig.HsmDevType == HsmDevTypeYubi {
-		pv = NewYubiHsmPrivVal(hsmConfig)
-	} else {
-		return nil, errors.New("Unsupported HSM type")
+	pv, err := newHsmPV(hsmConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := pv.LoadPrivVal(filePath); err != nil {
@@ -76,3 +67,15 @@ func LoadHsmPV(hsmConfig *HsmConfig, filePath string) (HsmPrivVal, error) {
 
 	return pv, nil
 }
+
+// creates an uninitialized priv validator for the configured HSM device type
+func newHsmPV(hsmConfig *HsmConfig) (HsmPrivVal, error) {
+	switch hsmConfig.HsmDevType {
+	case HsmDevTypeYubi:
+		return NewYubiHsmPrivVal(hsmConfig), nil
+	case HsmDevTypePkcs11, HsmDevTypeSoft:
+		return NewPkcs11PrivVal(hsmConfig), nil
+	default:
+		return nil, errors.New("Unsupported HSM type")
+	}
+}
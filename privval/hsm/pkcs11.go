@@ -0,0 +1,431 @@
+package hsmpv
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/types"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// PKCS#11 v3.0 constants for Ed25519 keys, older versions of the pkcs11 package don't define them
+const (
+	ckkECEdwards           = 0x00000040
+	ckmECEdwardsKeyPairGen = 0x00001055
+	ckmEdDSA               = 0x00001057
+)
+
+const Pkcs11SignKeyLabel = "loomchain-hsm-pv"
+
+// DER encoding of the Ed25519 curve OID (1.3.101.112)
+var ed25519CurveOID = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+
+// Pkcs11PV implements priv validator for HSMs that can be accessed via a PKCS#11 library, such as
+// SoftHSM.
+type Pkcs11PV struct {
+	LastHeight int64 `json:"last_height"`
+	LastRound  int   `json:"last_round"`
+	LastStep   int8  `json:"last_step"`
+
+	LastSignature []byte       `json:"last_signature,omitempty"`
+	LastSignBytes cmn.HexBytes `json:"last_signbytes,omitempty"`
+
+	Address   types.Address `json:"address"`
+	SignKeyID uint16        `json:"key_id"`
+
+	PubKey crypto.PubKey `json:"pub_key"`
+
+	hsmConfig *HsmConfig
+	ctx       *pkcs11.Ctx
+	session   pkcs11.SessionHandle
+	privKey   pkcs11.ObjectHandle
+
+	filePath string
+	mtx      sync.Mutex
+}
+
+// NewPkcs11PrivVal creates a new instance of PKCS#11 priv validator
+func NewPkcs11PrivVal(hsmConfig *HsmConfig) *Pkcs11PV {
+	return &Pkcs11PV{
+		hsmConfig: hsmConfig,
+	}
+}
+
+// GenPrivVal generates PKCS#11 priv validator
+func (pv *Pkcs11PV) GenPrivVal(filePath string) error {
+	if err := pv.openSession(); err != nil {
+		return err
+	}
+
+	// generate keypair
+	if pv.SignKeyID == 0 && pv.hsmConfig.HsmSignKeyID != 0 {
+		//first run we dont need to regen the priv keyid
+		pv.SignKeyID = pv.hsmConfig.HsmSignKeyID
+	}
+	var err error
+	if pv.SignKeyID == 0 {
+		err = pv.genEd25519KeyPair()
+	} else {
+		err = pv.findPrivKey()
+	}
+	if err != nil {
+		pv.Destroy()
+		return err
+	}
+
+	// export public key
+	if err := pv.exportEd25519PubKey(); err != nil {
+		pv.Destroy()
+		return err
+	}
+
+	pv.filePath = filePath
+	return nil
+}
+
+// LoadPrivVal loads PKCS#11 priv validator from file
+func (pv *Pkcs11PV) LoadPrivVal(filePath string) error {
+	// parse priv validator file
+	pvJSONBytes, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	err = cdc.UnmarshalJSON(pvJSONBytes, &pv)
+	if err != nil {
+		return err
+	}
+
+	if err := pv.openSession(); err != nil {
+		return err
+	}
+	if err := pv.findPrivKey(); err != nil {
+		pv.Destroy()
+		return err
+	}
+
+	// export pubkey
+	if err := pv.exportEd25519PubKey(); err != nil {
+		pv.Destroy()
+		return err
+	}
+
+	pv.filePath = filePath
+	return nil
+}
+
+// Destroy PKCS#11 priv validator
+func (pv *Pkcs11PV) Destroy() {
+	if pv.ctx == nil {
+		return
+	}
+	pv.ctx.Logout(pv.session)
+	pv.ctx.CloseSession(pv.session)
+	pv.ctx.Finalize()
+	pv.ctx.Destroy()
+	pv.ctx = nil
+}
+
+// Reset parameters with given height
+func (pv *Pkcs11PV) Reset(height int64) {
+	pv.LastHeight = height
+	pv.LastRound = 0
+	pv.LastStep = 0
+}
+
+// Save PKCS#11 priv validator to file
+func (pv *Pkcs11PV) Save() {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	pv.save()
+}
+
+func (pv *Pkcs11PV) save() {
+	outFile := pv.filePath
+	if outFile == "" {
+		panic("Cannot save PKCS#11 PrivValidator: filePath not set")
+	}
+
+	jsonBytes, err := cdc.MarshalJSONIndent(pv, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	err = cmn.WriteFileAtomic(outFile, jsonBytes, 0600)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetPubKey gets public key
+func (pv *Pkcs11PV) GetPubKey() crypto.PubKey {
+	return pv.PubKey
+}
+
+// GetAddress gets address of public key
+func (pv *Pkcs11PV) GetAddress() types.Address {
+	return pv.PubKey.Address()
+}
+
+// SignVote signs vote
+func (pv *Pkcs11PV) SignVote(chainID string, vote *types.Vote) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if err := signVote(pv, chainID, vote); err != nil {
+		return fmt.Errorf("Error signing vote: %v", err)
+	}
+	return nil
+}
+
+// SignProposal signs proposal
+func (pv *Pkcs11PV) SignProposal(chainID string, proposal *types.Proposal) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if err := signProposal(pv, chainID, proposal); err != nil {
+		return fmt.Errorf("Error signing proposal: %v", err)
+	}
+	return nil
+}
+
+func (pv *Pkcs11PV) Sign(msg []byte) ([]byte, error) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	return pv.signBytes(msg)
+}
+
+// load the PKCS#11 library, and log into the token
+func (pv *Pkcs11PV) openSession() error {
+	ctx := pkcs11.New(pv.hsmConfig.HsmP11LibPath)
+	if ctx == nil {
+		return fmt.Errorf("failed to load PKCS#11 library %s", pv.hsmConfig.HsmP11LibPath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+		return err
+	}
+
+	slot, err := findTokenSlot(ctx, pv.hsmConfig.HsmTokenLabel)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return err
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		ctx.Finalize()
+		ctx.Destroy()
+		return err
+	}
+
+	if err := ctx.Login(session, pkcs11.CKU_USER, pv.hsmConfig.HsmAuthPassword); err != nil {
+		ctx.CloseSession(session)
+		ctx.Finalize()
+		ctx.Destroy()
+		return err
+	}
+
+	pv.ctx = ctx
+	pv.session = session
+	return nil
+}
+
+// find the slot of the token with the given label
+func findTokenSlot(ctx *pkcs11.Ctx, label string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, err
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, err
+		}
+		// token labels are padded with spaces
+		if strings.TrimRight(info.Label, " \x00") == label {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("PKCS#11 token %s not found", label)
+}
+
+func keyIDBytes(keyID uint16) []byte {
+	id := make([]byte, 2)
+	binary.BigEndian.PutUint16(id, keyID)
+	return id
+}
+
+// find the object of the given class with the given key ID, returns zero if there's no such object
+func (pv *Pkcs11PV) findObject(class uint, keyID uint16) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, keyIDBytes(keyID)),
+	}
+	if err := pv.ctx.FindObjectsInit(pv.session, template); err != nil {
+		return 0, err
+	}
+	objects, _, err := pv.ctx.FindObjects(pv.session, 1)
+	if finalErr := pv.ctx.FindObjectsFinal(pv.session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(objects) == 0 {
+		return 0, nil
+	}
+	return objects[0], nil
+}
+
+func (pv *Pkcs11PV) findPrivKey() error {
+	privKey, err := pv.findObject(pkcs11.CKO_PRIVATE_KEY, pv.SignKeyID)
+	if err != nil {
+		return err
+	}
+	if privKey == 0 {
+		return fmt.Errorf("PKCS#11 sign key %d not found", pv.SignKeyID)
+	}
+	pv.privKey = privKey
+	return nil
+}
+
+// generate ed25519 keypair with an unused key ID
+func (pv *Pkcs11PV) genEd25519KeyPair() error {
+	keyID, err := pv.newKeyID()
+	if err != nil {
+		return err
+	}
+	id := keyIDBytes(keyID)
+
+	pubKeyTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, ckkECEdwards),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ed25519CurveOID),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, Pkcs11SignKeyLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+	}
+	privKeyTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, ckkECEdwards),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, Pkcs11SignKeyLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+	}
+	_, privKey, err := pv.ctx.GenerateKeyPair(
+		pv.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(ckmECEdwardsKeyPairGen, nil)},
+		pubKeyTemplate,
+		privKeyTemplate,
+	)
+	if err != nil {
+		return err
+	}
+
+	// set sign key ID
+	pv.SignKeyID = keyID
+	pv.privKey = privKey
+	return nil
+}
+
+// pick a random key ID that isn't used by any private key on the token
+func (pv *Pkcs11PV) newKeyID() (uint16, error) {
+	for i := 0; i < 100; i++ {
+		var buf [2]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			return 0, err
+		}
+		keyID := binary.BigEndian.Uint16(buf[:])
+		if keyID == 0 {
+			continue
+		}
+		obj, err := pv.findObject(pkcs11.CKO_PRIVATE_KEY, keyID)
+		if err != nil {
+			return 0, err
+		}
+		if obj == 0 {
+			return keyID, nil
+		}
+	}
+	return 0, errors.New("failed to find an unused PKCS#11 key ID")
+}
+
+// export ed25519 public key
+func (pv *Pkcs11PV) exportEd25519PubKey() error {
+	pubKeyObj, err := pv.findObject(pkcs11.CKO_PUBLIC_KEY, pv.SignKeyID)
+	if err != nil {
+		return err
+	}
+	if pubKeyObj == 0 {
+		return fmt.Errorf("PKCS#11 public key %d not found", pv.SignKeyID)
+	}
+
+	attrs, err := pv.ctx.GetAttributeValue(
+		pv.session, pubKeyObj, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)},
+	)
+	if err != nil {
+		return err
+	}
+	if len(attrs) == 0 {
+		return errors.New("PKCS#11 public key has no EC point")
+	}
+
+	// The EC point is usually a DER encoded octet string, but some libraries return the raw key
+	point := attrs[0].Value
+	if len(point) == ed25519.PubKeyEd25519Size+2 && point[0] == 0x04 && point[1] == ed25519.PubKeyEd25519Size {
+		point = point[2:]
+	}
+	if len(point) != ed25519.PubKeyEd25519Size {
+		return fmt.Errorf("invalid PKCS#11 public key size %d", len(point))
+	}
+
+	// Convert raw key data to tendermint PubKey type
+	var publicKey ed25519.PubKeyEd25519
+	copy(publicKey[:], point)
+	pv.PubKey = publicKey
+	pv.Address = publicKey.Address()
+
+	return nil
+}
+
+// sign bytes using EdDSA
+func (pv *Pkcs11PV) signBytes(data []byte) ([]byte, error) {
+	mechanism := []*pkcs11.Mechanism{pkcs11.NewMechanism(ckmEdDSA, nil)}
+	if err := pv.ctx.SignInit(pv.session, mechanism, pv.privKey); err != nil {
+		return nil, err
+	}
+	return pv.ctx.Sign(pv.session, data)
+}
+
+// returns the last signed height/round/step, sign bytes & signature
+func (pv *Pkcs11PV) lastSigned() (int64, int, int8, []byte, []byte) {
+	return pv.LastHeight, pv.LastRound, pv.LastStep, pv.LastSignBytes, pv.LastSignature
+}
+
+// Persist height/round/step and signature
+func (pv *Pkcs11PV) saveSigned(height int64, round int, step int8, signBytes []byte, sig []byte) {
+	pv.LastHeight = height
+	pv.LastRound = round
+	pv.LastStep = step
+	pv.LastSignature = sig
+	pv.LastSignBytes = signBytes
+	pv.save()
+}
@@ -0,0 +1,89 @@
+package hsmpv
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/types"
+)
+
+// These tests require a PKCS#11 token with an Ed25519 capable library, e.g. SoftHSM v2.5+:
+//
+//   softhsm2-util --init-token --free --label loomchain --pin 1234 --so-pin 1234
+//   HSM_PKCS11_TEST_ENABLE=true HSM_P11_LIB_PATH=/usr/lib/softhsm/libsofthsm2.so \
+//     HSM_TOKEN_LABEL=loomchain HSM_PIN=1234 go test ./privval/hsm/...
+
+func pkcs11TestConfig(t *testing.T) *HsmConfig {
+	if os.Getenv("HSM_PKCS11_TEST_ENABLE") != "true" {
+		t.Skip("PKCS#11 HSM Test Disabled")
+	}
+	hsmConfig := DefaultConfig()
+	hsmConfig.HsmEnabled = true
+	hsmConfig.HsmDevType = HsmDevTypePkcs11
+	hsmConfig.HsmP11LibPath = os.Getenv("HSM_P11_LIB_PATH")
+	hsmConfig.HsmTokenLabel = os.Getenv("HSM_TOKEN_LABEL")
+	hsmConfig.HsmAuthPassword = os.Getenv("HSM_PIN")
+	return hsmConfig
+}
+
+func newTestVote(pv types.PrivValidator, height int64, blockHash []byte) *types.Vote {
+	return &types.Vote{
+		Type:             types.PrevoteType,
+		Height:           height,
+		Timestamp:        time.Now(),
+		BlockID:          types.BlockID{Hash: blockHash},
+		ValidatorAddress: pv.GetAddress(),
+	}
+}
+
+func TestPkcs11PrivVal(t *testing.T) {
+	hsmConfig := pkcs11TestConfig(t)
+
+	dir, err := ioutil.TempDir("", "pkcs11-pv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pvFile := filepath.Join(dir, "priv_validator.json")
+
+	pv, err := GenHsmPV(hsmConfig, pvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pv.Save()
+
+	vote := newTestVote(pv, 1, tmhash.Sum([]byte("block1")))
+	if err := pv.SignVote("default", vote); err != nil {
+		t.Fatal(err)
+	}
+	if !pv.GetPubKey().VerifyBytes(vote.SignBytes("default"), vote.Signature) {
+		t.Fatal("verifying vote signature has failed")
+	}
+	pv.Destroy()
+
+	// the double signing protection state should survive a restart
+	pv, err = LoadHsmPV(hsmConfig, pvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pv.Destroy()
+
+	if err := pv.SignVote("default", newTestVote(pv, 1, tmhash.Sum([]byte("block2")))); err == nil {
+		t.Fatal("conflicting vote was signed")
+	}
+
+	msg := []byte("test")
+	sig, err := pv.Sign(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := pv.GetPubKey().(ed25519.PubKeyEd25519)
+	if !pubKey.VerifyBytes(msg, sig) {
+		t.Fatal("verifying signature has failed")
+	}
+}
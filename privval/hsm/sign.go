@@ -0,0 +1,199 @@
+package hsmpv
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+// signGuard is implemented by the HSM priv validators, the signing functions below use it to
+// prevent a validator from signing conflicting votes or proposals.
+type signGuard interface {
+	// lastSigned returns the height/round/step, sign bytes & signature of the last vote or proposal
+	// signed by the validator
+	lastSigned() (height int64, round int, step int8, signBytes []byte, sig []byte)
+	// saveSigned persists the height/round/step, sign bytes & signature of a newly signed vote or
+	// proposal
+	saveSigned(height int64, round int, step int8, signBytes []byte, sig []byte)
+	signBytes(data []byte) ([]byte, error)
+}
+
+// TODO: type ?
+const (
+	//nolint:unused
+	stepNone      int8 = 0 // Used to distinguish the initial state
+	stepPropose   int8 = 1
+	stepPrevote   int8 = 2
+	stepPrecommit int8 = 3
+)
+
+func voteToStep(vote *types.Vote) int8 {
+	switch vote.Type {
+	case types.PrevoteType:
+		return stepPrevote
+	case types.PrecommitType:
+		return stepPrecommit
+	default:
+		cmn.PanicSanity("Unknown vote type")
+		return 0
+	}
+}
+
+// returns error if HRS regression or no LastSignBytes. returns true if HRS is unchanged
+func checkHRS(g signGuard, height int64, round int, step int8) (bool, error) {
+	lastHeight, lastRound, lastStep, lastSignBytes, lastSignature := g.lastSigned()
+
+	if lastHeight > height {
+		return false, errors.New("Height regression")
+	}
+
+	if lastHeight == height {
+		if lastRound > round {
+			return false, errors.New("Round regression")
+		}
+
+		if lastRound == round {
+			if lastStep > step {
+				return false, errors.New("Step regression")
+			} else if lastStep == step {
+				if lastSignBytes != nil {
+					if lastSignature == nil {
+						panic("pv: LastSignature is nil but LastSignBytes is not!")
+					}
+					return true, nil
+				}
+				return false, errors.New("No LastSignature found")
+			}
+		}
+	}
+	return false, nil
+}
+
+// signVote checks if the vote is good to sign and sets the vote signature.
+// It may need to set the timestamp as well if the vote is otherwise the same as
+// a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
+func signVote(g signGuard, chainID string, vote *types.Vote) error {
+	height, round, step := vote.Height, vote.Round, voteToStep(vote)
+	signBytes := vote.SignBytes(chainID)
+
+	sameHRS, err := checkHRS(g, height, round, step)
+	if err != nil {
+		return err
+	}
+
+	// We might crash before writing to the wal,
+	// causing us to try to re-sign for the same HRS.
+	// If signbytes are the same, use the last signature.
+	// If they only differ by timestamp, use last timestamp and signature
+	// Otherwise, return error
+	if sameHRS {
+		_, _, _, lastSignBytes, lastSignature := g.lastSigned()
+		if bytes.Equal(signBytes, lastSignBytes) {
+			vote.Signature = lastSignature
+		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(lastSignBytes, signBytes); ok {
+			vote.Timestamp = timestamp
+			vote.Signature = lastSignature
+		} else {
+			err = fmt.Errorf("Conflicting data")
+		}
+		return err
+	}
+
+	// It passed the checks. Sign the vote
+	sig, err := g.signBytes(signBytes)
+	if err != nil {
+		return err
+	}
+	g.saveSigned(height, round, step, signBytes, sig)
+	vote.Signature = sig
+	return nil
+}
+
+// signProposal checks if the proposal is good to sign and sets the proposal signature.
+// It may need to set the timestamp as well if the proposal is otherwise the same as
+// a previously signed proposal ie. we crashed after signing but before the proposal hit the WAL).
+func signProposal(g signGuard, chainID string, proposal *types.Proposal) error {
+	height, round, step := proposal.Height, proposal.Round, stepPropose
+	signBytes := proposal.SignBytes(chainID)
+
+	sameHRS, err := checkHRS(g, height, round, step)
+	if err != nil {
+		return err
+	}
+
+	// We might crash before writing to the wal,
+	// causing us to try to re-sign for the same HRS.
+	// If signbytes are the same, use the last signature.
+	// If they only differ by timestamp, use last timestamp and signature
+	// Otherwise, return error
+	if sameHRS {
+		_, _, _, lastSignBytes, lastSignature := g.lastSigned()
+		if bytes.Equal(signBytes, lastSignBytes) {
+			proposal.Signature = lastSignature
+		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(lastSignBytes, signBytes); ok {
+			proposal.Timestamp = timestamp
+			proposal.Signature = lastSignature
+		} else {
+			err = fmt.Errorf("Conflicting data")
+		}
+		return err
+	}
+
+	// It passed the checks. Sign the proposal
+	sig, err := g.signBytes(signBytes)
+	if err != nil {
+		return err
+	}
+	g.saveSigned(height, round, step, signBytes, sig)
+	proposal.Signature = sig
+	return nil
+}
+
+// returns the timestamp from the lastSignBytes.
+// returns true if the only difference in the votes is their timestamp.
+func checkVotesOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
+	var lastVote, newVote types.CanonicalVote
+	if err := cdc.UnmarshalBinaryLengthPrefixed(lastSignBytes, &lastVote); err != nil {
+		panic(fmt.Sprintf("LastSignBytes cannot be unmarshalled into vote: %v", err))
+	}
+	if err := cdc.UnmarshalBinaryLengthPrefixed(newSignBytes, &newVote); err != nil {
+		panic(fmt.Sprintf("signBytes cannot be unmarshalled into vote: %v", err))
+	}
+
+	lastTime := lastVote.Timestamp
+	// set the times to the same value and check equality
+	now := tmtime.Now()
+	lastVote.Timestamp = now
+	newVote.Timestamp = now
+	lastVoteBytes, _ := cdc.MarshalJSON(lastVote)
+	newVoteBytes, _ := cdc.MarshalJSON(newVote)
+
+	return lastTime, bytes.Equal(newVoteBytes, lastVoteBytes)
+}
+
+// returns the timestamp from the lastSignBytes.
+// returns true if the only difference in the proposals is their timestamp
+func checkProposalsOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
+	var lastProposal, newProposal types.CanonicalProposal
+	if err := cdc.UnmarshalBinaryLengthPrefixed(lastSignBytes, &lastProposal); err != nil {
+		panic(fmt.Sprintf("LastSignBytes cannot be unmarshalled into proposal: %v", err))
+	}
+	if err := cdc.UnmarshalBinaryLengthPrefixed(newSignBytes, &newProposal); err != nil {
+		panic(fmt.Sprintf("signBytes cannot be unmarshalled into proposal: %v", err))
+	}
+
+	lastTime := lastProposal.Timestamp
+	// set the times to the same value and check equality
+	now := tmtime.Now()
+	lastProposal.Timestamp = now
+	newProposal.Timestamp = now
+	lastProposalBytes, _ := cdc.MarshalBinaryLengthPrefixed(lastProposal)
+	newProposalBytes, _ := cdc.MarshalBinaryLengthPrefixed(newProposal)
+
+	return lastTime, bytes.Equal(newProposalBytes, lastProposalBytes)
+}
@@ -0,0 +1,99 @@
+package hsmpv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/types"
+)
+
+// signGuard implementation that keeps its state in memory
+type memSignGuard struct {
+	privKey ed25519.PrivKeyEd25519
+
+	lastHeight    int64
+	lastRound     int
+	lastStep      int8
+	lastSignBytes []byte
+	lastSignature []byte
+}
+
+func (g *memSignGuard) lastSigned() (int64, int, int8, []byte, []byte) {
+	return g.lastHeight, g.lastRound, g.lastStep, g.lastSignBytes, g.lastSignature
+}
+
+func (g *memSignGuard) saveSigned(height int64, round int, step int8, signBytes []byte, sig []byte) {
+	g.lastHeight = height
+	g.lastRound = round
+	g.lastStep = step
+	g.lastSignBytes = signBytes
+	g.lastSignature = sig
+}
+
+func (g *memSignGuard) signBytes(data []byte) ([]byte, error) {
+	return g.privKey.Sign(data)
+}
+
+func TestSignGuard(t *testing.T) {
+	g := &memSignGuard{privKey: ed25519.GenPrivKey()}
+	chainID := "default"
+	blockHash := tmhash.Sum([]byte("block"))
+	now := time.Now()
+
+	newVote := func(voteType types.SignedMsgType, height int64, round int, hash []byte) *types.Vote {
+		return &types.Vote{
+			Type:      voteType,
+			Height:    height,
+			Round:     round,
+			Timestamp: now,
+			BlockID:   types.BlockID{Hash: hash},
+		}
+	}
+
+	vote := newVote(types.PrecommitType, 2, 1, blockHash)
+	if err := signVote(g, chainID, vote); err != nil {
+		t.Fatal(err)
+	}
+	if !g.privKey.PubKey().VerifyBytes(vote.SignBytes(chainID), vote.Signature) {
+		t.Fatal("verifying vote signature has failed")
+	}
+
+	// re-signing the same vote, or one that only differs by timestamp, returns the last signature
+	sameVote := newVote(types.PrecommitType, 2, 1, blockHash)
+	sameVote.Timestamp = now.Add(time.Second)
+	if err := signVote(g, chainID, sameVote); err != nil {
+		t.Fatal(err)
+	}
+	if string(sameVote.Signature) != string(vote.Signature) || !sameVote.Timestamp.Equal(vote.Timestamp) {
+		t.Fatal("expected last signature & timestamp to be reused")
+	}
+
+	regressions := []*types.Vote{
+		newVote(types.PrecommitType, 2, 1, tmhash.Sum([]byte("other block"))), // conflicting data
+		newVote(types.PrevoteType, 2, 1, blockHash),                           // step regression
+		newVote(types.PrecommitType, 2, 0, blockHash),                         // round regression
+		newVote(types.PrecommitType, 1, 1, blockHash),                         // height regression
+	}
+	for i, v := range regressions {
+		if err := signVote(g, chainID, v); err == nil {
+			t.Fatalf("vote %d should've been refused", i)
+		}
+	}
+
+	proposal := &types.Proposal{
+		Type:      types.ProposalType,
+		Height:    3,
+		Round:     0,
+		POLRound:  -1,
+		BlockID:   types.BlockID{Hash: blockHash},
+		Timestamp: now,
+	}
+	if err := signProposal(g, chainID, proposal); err != nil {
+		t.Fatal(err)
+	}
+	if g.lastHeight != 3 || g.lastStep != stepPropose {
+		t.Fatal("expected proposal to be saved")
+	}
+}
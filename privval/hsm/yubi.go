@@ -1,18 +1,14 @@
 package hsmpv
 
 import (
-	"bytes"
 	"crypto/sha256"
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"sync"
-	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/types"
-	tmtime "github.com/tendermint/tendermint/types/time"
 
 	cmn "github.com/tendermint/tendermint/libs/common"
 
@@ -46,27 +42,6 @@ type YubiHsmPV struct {
 	mtx      sync.Mutex
 }
 
-// TODO: type ?
-const (
-	//nolint:unused
-	stepNone      int8 = 0 // Used to distinguish the initial state
-	stepPropose   int8 = 1
-	stepPrevote   int8 = 2
-	stepPrecommit int8 = 3
-)
-
-func voteToStep(vote *types.Vote) int8 {
-	switch vote.Type {
-	case types.PrevoteType:
-		return stepPrevote
-	case types.PrecommitType:
-		return stepPrecommit
-	default:
-		cmn.PanicSanity("Unknown vote type")
-		return 0
-	}
-}
-
 // NewYubiHsmPV creates a new instance of YubiHSM priv validator
 func NewYubiHsmPrivVal(hsmConfig *HsmConfig) *YubiHsmPV {
 	yubiHsmPV := &YubiHsmPV{}
@@ -199,7 +174,7 @@ func (pv *YubiHsmPV) SignVote(chainID string, vote *types.Vote) error {
 	pv.mtx.Lock()
 	defer pv.mtx.Unlock()
 
-	if err := pv.signVote(chainID, vote); err != nil {
+	if err := signVote(pv, chainID, vote); err != nil {
 		return fmt.Errorf("Error signing vote: %v", err)
 	}
 	return nil
@@ -210,7 +185,7 @@ func (pv *YubiHsmPV) SignProposal(chainID string, proposal *types.Proposal) erro
 	pv.mtx.Lock()
 	defer pv.mtx.Unlock()
 
-	if err := pv.signProposal(chainID, proposal); err != nil {
+	if err := signProposal(pv, chainID, proposal); err != nil {
 		return fmt.Errorf("Error signing proposal: %v", err)
 	}
 	return nil
@@ -267,32 +242,9 @@ func (pv *YubiHsmPV) verifySig(msg []byte, sig []byte) bool {
 	return pubKey.VerifyBytes(msg, sig)
 }
 
-// returns error if HRS regression or no LastSignBytes. returns true if HRS is unchanged
-func (pv *YubiHsmPV) checkHRS(height int64, round int, step int8) (bool, error) {
-	if pv.LastHeight > height {
-		return false, errors.New("Height regression")
-	}
-
-	if pv.LastHeight == height {
-		if pv.LastRound > round {
-			return false, errors.New("Round regression")
-		}
-
-		if pv.LastRound == round {
-			if pv.LastStep > step {
-				return false, errors.New("Step regression")
-			} else if pv.LastStep == step {
-				if pv.LastSignBytes != nil {
-					if pv.LastSignature == nil {
-						panic("pv: LastSignature is nil but LastSignBytes is not!")
-					}
-					return true, nil
-				}
-				return false, errors.New("No LastSignature found")
-			}
-		}
-	}
-	return false, nil
+// returns the last signed height/round/step, sign bytes & signature
+func (pv *YubiHsmPV) lastSigned() (int64, int, int8, []byte, []byte) {
+	return pv.LastHeight, pv.LastRound, pv.LastStep, pv.LastSignBytes, pv.LastSignature
 }
 
 // Persist height/round/step and signature
@@ -306,125 +258,3 @@ func (pv *YubiHsmPV) saveSigned(height int64, round int, step int8,
 	pv.LastSignBytes = signBytes
 	pv.save()
 }
-
-// signVote checks if the vote is good to sign and sets the vote signature.
-// It may need to set the timestamp as well if the vote is otherwise the same as
-// a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
-func (pv *YubiHsmPV) signVote(chainID string, vote *types.Vote) error {
-	height, round, step := vote.Height, vote.Round, voteToStep(vote)
-	signBytes := vote.SignBytes(chainID)
-
-	sameHRS, err := pv.checkHRS(height, round, step)
-	if err != nil {
-		return err
-	}
-
-	// We might crash before writing to the wal,
-	// causing us to try to re-sign for the same HRS.
-	// If signbytes are the same, use the last signature.
-	// If they only differ by timestamp, use last timestamp and signature
-	// Otherwise, return error
-	if sameHRS {
-		if bytes.Equal(signBytes, pv.LastSignBytes) {
-			vote.Signature = pv.LastSignature
-		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(pv.LastSignBytes, signBytes); ok {
-			vote.Timestamp = timestamp
-			vote.Signature = pv.LastSignature
-		} else {
-			err = fmt.Errorf("Conflicting data")
-		}
-		return err
-	}
-
-	// It passed the checks. Sign the vote
-	sig, err := pv.signBytes(signBytes)
-	if err != nil {
-		return err
-	}
-	pv.saveSigned(height, round, step, signBytes, sig)
-	vote.Signature = sig
-	return nil
-}
-
-// signProposal checks if the proposal is good to sign and sets the proposal signature.
-// It may need to set the timestamp as well if the proposal is otherwise the same as
-// a previously signed proposal ie. we crashed after signing but before the proposal hit the WAL).
-func (pv *YubiHsmPV) signProposal(chainID string, proposal *types.Proposal) error {
-	height, round, step := proposal.Height, proposal.Round, stepPropose
-	signBytes := proposal.SignBytes(chainID)
-
-	sameHRS, err := pv.checkHRS(height, round, step)
-	if err != nil {
-		return err
-	}
-
-	// We might crash before writing to the wal,
-	// causing us to try to re-sign for the same HRS.
-	// If signbytes are the same, use the last signature.
-	// If they only differ by timestamp, use last timestamp and signature
-	// Otherwise, return error
-	if sameHRS {
-		if bytes.Equal(signBytes, pv.LastSignBytes) {
-			proposal.Signature = pv.LastSignature
-		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(pv.LastSignBytes, signBytes); ok {
-			proposal.Timestamp = timestamp
-			proposal.Signature = pv.LastSignature
-		} else {
-			err = fmt.Errorf("Conflicting data")
-		}
-		return err
-	}
-
-	// It passed the checks. Sign the proposal
-	sig, err := pv.signBytes(signBytes)
-	if err != nil {
-		return err
-	}
-	pv.saveSigned(height, round, step, signBytes, sig)
-	proposal.Signature = sig
-	return nil
-}
-
-// returns the timestamp from the lastSignBytes.
-// returns true if the only difference in the votes is their timestamp.
-func checkVotesOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
-	var lastVote, newVote types.CanonicalVote
-	if err := cdc.UnmarshalBinaryLengthPrefixed(lastSignBytes, &lastVote); err != nil {
-		panic(fmt.Sprintf("LastSignBytes cannot be unmarshalled into vote: %v", err))
-	}
-	if err := cdc.UnmarshalBinaryLengthPrefixed(newSignBytes, &newVote); err != nil {
-		panic(fmt.Sprintf("signBytes cannot be unmarshalled into vote: %v", err))
-	}
-
-	lastTime := lastVote.Timestamp
-	// set the times to the same value and check equality
-	now := tmtime.Now()
-	lastVote.Timestamp = now
-	newVote.Timestamp = now
-	lastVoteBytes, _ := cdc.MarshalJSON(lastVote)
-	newVoteBytes, _ := cdc.MarshalJSON(newVote)
-
-	return lastTime, bytes.Equal(newVoteBytes, lastVoteBytes)
-}
-
-// returns the timestamp from the lastSignBytes.
-// returns true if the only difference in the proposals is their timestamp
-func checkProposalsOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) (time.Time, bool) {
-	var lastProposal, newProposal types.CanonicalProposal
-	if err := cdc.UnmarshalBinaryLengthPrefixed(lastSignBytes, &lastProposal); err != nil {
-		panic(fmt.Sprintf("LastSignBytes cannot be unmarshalled into proposal: %v", err))
-	}
-	if err := cdc.UnmarshalBinaryLengthPrefixed(newSignBytes, &newProposal); err != nil {
-		panic(fmt.Sprintf("signBytes cannot be unmarshalled into proposal: %v", err))
-	}
-
-	lastTime := lastProposal.Timestamp
-	// set the times to the same value and check equality
-	now := tmtime.Now()
-	lastProposal.Timestamp = now
-	newProposal.Timestamp = now
-	lastProposalBytes, _ := cdc.MarshalBinaryLengthPrefixed(lastProposal)
-	newProposalBytes, _ := cdc.MarshalBinaryLengthPrefixed(newProposal)
-
-	return lastTime, bytes.Equal(newProposalBytes, lastProposalBytes)
-}
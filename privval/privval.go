@@ -2,11 +2,14 @@ package privval
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/loomnetwork/go-loom/auth"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/types"
 
 	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
+	remotepv "github.com/loomnetwork/loomchain/privval/remote"
 )
 
 type PrivValidator interface {
@@ -15,8 +18,14 @@ type PrivValidator interface {
 	Reset(height int64)
 }
 
-// generate priv validator while generating ed25519 keypair
-func GenPrivVal(filePath string, hsmConfig *hsmpv.HsmConfig) (PrivValidator, error) {
+// generate priv validator while generating ed25519 keypair, when a remote signer is used the
+// keypair must be generated on the remote signer host instead
+func GenPrivVal(
+	filePath string, hsmConfig *hsmpv.HsmConfig, remoteCfg *remotepv.RemoteSignerConfig,
+) (PrivValidator, error) {
+	if remoteCfg.IsEnabled() {
+		return remotepv.NewRemoteSignerPV(remoteCfg)
+	}
 	if hsmConfig.HsmEnabled {
 		return hsmpv.GenHsmPV(hsmConfig, filePath)
 	}
@@ -24,8 +33,42 @@ func GenPrivVal(filePath string, hsmConfig *hsmpv.HsmConfig) (PrivValidator, err
 	return GenFilePV(filePath)
 }
 
+// GenRotatedPrivVal generates a priv validator with a new ed25519 keypair to replace the current
+// validator key, the priv validator is saved to filePath which must not exist yet.
+// When an HSM is used a new keypair is always generated in the HSM, even if the HSM config
+// specifies the ID of an existing key.
+func GenRotatedPrivVal(
+	filePath string, hsmConfig *hsmpv.HsmConfig, remoteCfg *remotepv.RemoteSignerConfig,
+) (PrivValidator, error) {
+	if remoteCfg.IsEnabled() {
+		return nil, fmt.Errorf("the new validator key must be generated on the remote signer host")
+	}
+	if _, err := os.Stat(filePath); err == nil {
+		return nil, fmt.Errorf("%s already exists", filePath)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	cfg := hsmConfig.Clone()
+	if cfg.HsmEnabled {
+		cfg.HsmSignKeyID = 0
+	}
+
+	pv, err := GenPrivVal(filePath, cfg, nil)
+	if err != nil {
+		return nil, err
+	}
+	pv.Save()
+	return pv, nil
+}
+
 // load priv validator
-func LoadPrivVal(filePath string, hsmConfig *hsmpv.HsmConfig) (PrivValidator, error) {
+func LoadPrivVal(
+	filePath string, hsmConfig *hsmpv.HsmConfig, remoteCfg *remotepv.RemoteSignerConfig,
+) (PrivValidator, error) {
+	if remoteCfg.IsEnabled() {
+		return remotepv.NewRemoteSignerPV(remoteCfg)
+	}
 	if hsmConfig.HsmEnabled {
 		return hsmpv.LoadHsmPV(hsmConfig, filePath)
 	}
@@ -40,7 +83,30 @@ func NewEd25519Signer(pv PrivValidator) auth.Signer {
 	case *FilePV:
 		privKey := [64]byte(v.GetPrivKey())
 		return auth.NewSigner(auth.SignerTypeEd25519, privKey[:])
+	case *hsmpv.Pkcs11PV:
+		return &privValEd25519Signer{pv: v}
+	case *remotepv.RemoteSignerPV:
+		return &privValEd25519Signer{pv: v}
 	default:
 		panic(fmt.Errorf("Unknown PrivValidator implementation %T", v))
 	}
 }
+
+// privValEd25519Signer signs messages with a validator key that's only accessible to the priv
+// validator, i.e. one held by a PKCS#11 HSM or a remote signer.
+type privValEd25519Signer struct {
+	pv types.PrivValidator
+}
+
+func (s *privValEd25519Signer) Sign(msg []byte) []byte {
+	sig, err := s.pv.Sign(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}
+
+func (s *privValEd25519Signer) PublicKey() []byte {
+	pubKey := [ed25519.PubKeyEd25519Size]byte(s.pv.GetPubKey().(ed25519.PubKeyEd25519))
+	return pubKey[:]
+}
@@ -0,0 +1,221 @@
+package remotepv
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	p2pconn "github.com/tendermint/tendermint/p2p/conn"
+	"github.com/tendermint/tendermint/types"
+)
+
+// RemoteSignerPV implements a priv validator that delegates signing to a remote signer, the
+// validator key and the double-signing protection state are only accessible to the remote signer.
+type RemoteSignerPV struct {
+	cfg          *RemoteSignerConfig
+	identityKey  crypto.PrivKey
+	signerPubKey crypto.PubKey
+	pubKey       crypto.PubKey
+
+	conn net.Conn
+	mtx  sync.Mutex
+}
+
+// NewRemoteSignerPV connects to the remote signer and fetches the validator public key from it.
+func NewRemoteSignerPV(cfg *RemoteSignerConfig) (*RemoteSignerPV, error) {
+	identityKey, err := LoadIdentityKey(cfg.IdentityKeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load remote signer identity key")
+	}
+	signerPubKey, err := ParsePubKey(cfg.SignerPubKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse remote signer public key")
+	}
+
+	pv := &RemoteSignerPV{
+		cfg:          cfg,
+		identityKey:  identityKey,
+		signerPubKey: signerPubKey,
+	}
+	resp, err := pv.call(&PubKeyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	pubKeyResp, ok := resp.(*PubKeyResponse)
+	if !ok {
+		pv.Close()
+		return nil, errUnexpectedResponse(resp)
+	}
+	if pubKeyResp.Error != nil {
+		pv.Close()
+		return nil, pubKeyResp.Error
+	}
+	pv.pubKey = pubKeyResp.PubKey
+	return pv, nil
+}
+
+// GetPubKey gets public key
+func (pv *RemoteSignerPV) GetPubKey() crypto.PubKey {
+	return pv.pubKey
+}
+
+// GetAddress gets address of public key
+func (pv *RemoteSignerPV) GetAddress() types.Address {
+	return pv.pubKey.Address()
+}
+
+// SignVote signs vote
+func (pv *RemoteSignerPV) SignVote(chainID string, vote *types.Vote) error {
+	resp, err := pv.call(&SignVoteRequest{ChainID: chainID, Vote: vote})
+	if err != nil {
+		return fmt.Errorf("Error signing vote: %v", err)
+	}
+	signed, ok := resp.(*SignedVoteResponse)
+	if !ok {
+		return fmt.Errorf("Error signing vote: %v", errUnexpectedResponse(resp))
+	}
+	if signed.Error != nil {
+		return fmt.Errorf("Error signing vote: %v", signed.Error)
+	}
+	if signed.Vote == nil {
+		return errors.New("Error signing vote: remote signer returned no vote")
+	}
+	// The remote signer may have changed the timestamp if it had already signed the same vote
+	*vote = *signed.Vote
+	return nil
+}
+
+// SignProposal signs proposal
+func (pv *RemoteSignerPV) SignProposal(chainID string, proposal *types.Proposal) error {
+	resp, err := pv.call(&SignProposalRequest{ChainID: chainID, Proposal: proposal})
+	if err != nil {
+		return fmt.Errorf("Error signing proposal: %v", err)
+	}
+	signed, ok := resp.(*SignedProposalResponse)
+	if !ok {
+		return fmt.Errorf("Error signing proposal: %v", errUnexpectedResponse(resp))
+	}
+	if signed.Error != nil {
+		return fmt.Errorf("Error signing proposal: %v", signed.Error)
+	}
+	if signed.Proposal == nil {
+		return errors.New("Error signing proposal: remote signer returned no proposal")
+	}
+	*proposal = *signed.Proposal
+	return nil
+}
+
+// Sign signs an arbitrary message
+func (pv *RemoteSignerPV) Sign(msg []byte) ([]byte, error) {
+	resp, err := pv.call(&SignBytesRequest{Msg: msg})
+	if err != nil {
+		return nil, err
+	}
+	signed, ok := resp.(*SignedBytesResponse)
+	if !ok {
+		return nil, errUnexpectedResponse(resp)
+	}
+	if signed.Error != nil {
+		return nil, signed.Error
+	}
+	return signed.Signature, nil
+}
+
+// Save is a no-op, the remote signer persists its own state.
+func (pv *RemoteSignerPV) Save() {}
+
+// Reset is a no-op, the double-signing protection state can only be reset on the remote signer host.
+func (pv *RemoteSignerPV) Reset(height int64) {}
+
+// Close closes the connection to the remote signer.
+func (pv *RemoteSignerPV) Close() {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	pv.closeConn()
+}
+
+// call sends a request to the remote signer and waits for the response, connecting to the remote
+// signer first if necessary.
+func (pv *RemoteSignerPV) call(req RemoteSignerMsg) (RemoteSignerMsg, error) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	// If the remote signer dropped the connection since the last request the first attempt will
+	// fail, in which case the request is retried over a new connection. Retrying a request is safe
+	// since the remote signer returns the same signature for a vote or proposal it already signed.
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		var resp RemoteSignerMsg
+		resp, err = pv.roundTrip(req)
+		if err == nil {
+			return resp, nil
+		}
+		pv.closeConn()
+	}
+	return nil, err
+}
+
+func (pv *RemoteSignerPV) roundTrip(req RemoteSignerMsg) (RemoteSignerMsg, error) {
+	if pv.conn == nil {
+		conn, err := pv.connect()
+		if err != nil {
+			return nil, err
+		}
+		pv.conn = conn
+	}
+
+	timeout := time.Duration(pv.cfg.ReadWriteTimeout) * time.Second
+	if err := pv.conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	if err := writeMsg(pv.conn, req); err != nil {
+		return nil, errors.Wrap(err, "failed to send request to remote signer")
+	}
+	resp, err := readMsg(pv.conn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response from remote signer")
+	}
+	return resp, nil
+}
+
+func (pv *RemoteSignerPV) connect() (net.Conn, error) {
+	protocol, address := cmn.ProtocolAndAddress(pv.cfg.Address)
+	timeout := time.Duration(pv.cfg.DialTimeout) * time.Second
+	isSigner := func(pubKey crypto.PubKey) bool {
+		return pubKey.Equals(pv.signerPubKey)
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		var conn net.Conn
+		conn, err = net.DialTimeout(protocol, address, timeout)
+		if err == nil {
+			var sc *p2pconn.SecretConnection
+			sc, err = makeAuthenticatedConn(conn, pv.identityKey, timeout, isSigner)
+			if err == nil {
+				return sc, nil
+			}
+		}
+		if attempt >= pv.cfg.MaxDialAttempts {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	return nil, errors.Wrapf(err, "failed to connect to remote signer at %s", pv.cfg.Address)
+}
+
+func (pv *RemoteSignerPV) closeConn() {
+	if pv.conn != nil {
+		pv.conn.Close()
+		pv.conn = nil
+	}
+}
+
+func errUnexpectedResponse(resp RemoteSignerMsg) error {
+	return fmt.Errorf("unexpected response from remote signer %T", resp)
+}
@@ -0,0 +1,52 @@
+package remotepv
+
+// RemoteSignerConfig implements configurations for a remote signer
+type RemoteSignerConfig struct {
+	// flag to enable the remote signer
+	Enabled bool
+
+	// address of the remote signer, e.g. tcp://127.0.0.1:26659 or unix:///path/to/signer.sock
+	Address string
+
+	// base64 encoded ed25519 public key the remote signer authenticates itself with
+	SignerPubKey string
+
+	// path to the file containing the ed25519 key the node authenticates itself with
+	IdentityKeyFile string
+
+	// number of seconds to wait for a connection to the remote signer to be established
+	DialTimeout int64
+
+	// number of seconds to wait for the remote signer to respond to a request
+	ReadWriteTimeout int64
+
+	// number of times to try connecting to the remote signer before giving up
+	MaxDialAttempts int
+}
+
+// DefaultConfig creates new instance of RemoteSignerConfig with default config
+func DefaultConfig() *RemoteSignerConfig {
+	return &RemoteSignerConfig{
+		Enabled:          false,
+		Address:          "tcp://127.0.0.1:26659",
+		SignerPubKey:     "",
+		IdentityKeyFile:  "chaindata/config/remote_signer_identity.json",
+		DialTimeout:      3,
+		ReadWriteTimeout: 3,
+		MaxDialAttempts:  10,
+	}
+}
+
+// Clone returns a deep clone of the config.
+func (c *RemoteSignerConfig) Clone() *RemoteSignerConfig {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	return &clone
+}
+
+// IsEnabled returns true if the config is non-nil and the remote signer is enabled.
+func (c *RemoteSignerConfig) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
@@ -0,0 +1,99 @@
+package remotepv
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
+	p2pconn "github.com/tendermint/tendermint/p2p/conn"
+)
+
+// LoadIdentityKey loads the ed25519 key used to authenticate with the other end of a connection.
+func LoadIdentityKey(filePath string) (crypto.PrivKey, error) {
+	nodeKey, err := p2p.LoadNodeKey(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return nodeKey.PrivKey, nil
+}
+
+// GenIdentityKey generates a new ed25519 key that can be used to authenticate with the other end
+// of a connection, and saves it to filePath which must not exist yet.
+func GenIdentityKey(filePath string) (crypto.PrivKey, error) {
+	if _, err := os.Stat(filePath); err == nil {
+		return nil, fmt.Errorf("%s already exists", filePath)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	nodeKey, err := p2p.LoadOrGenNodeKey(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return nodeKey.PrivKey, nil
+}
+
+// ParsePubKey parses a base64 encoded ed25519 public key.
+func ParsePubKey(encoded string) (crypto.PubKey, error) {
+	keyBytes, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	if len(keyBytes) != ed25519.PubKeyEd25519Size {
+		return nil, fmt.Errorf("invalid public key size %d", len(keyBytes))
+	}
+	var pubKey ed25519.PubKeyEd25519
+	copy(pubKey[:], keyBytes)
+	return pubKey, nil
+}
+
+// EncodePubKey returns the base64 encoding of an ed25519 public key.
+func EncodePubKey(pubKey crypto.PubKey) string {
+	edPubKey, ok := pubKey.(ed25519.PubKeyEd25519)
+	if !ok {
+		return fmt.Sprintf("%v", pubKey)
+	}
+	return base64.StdEncoding.EncodeToString(edPubKey[:])
+}
+
+// makeAuthenticatedConn upgrades conn to an encrypted connection, and checks that the other end of
+// the connection authenticated itself with an accepted key. The connection is closed on failure.
+func makeAuthenticatedConn(
+	conn net.Conn, identityKey crypto.PrivKey, timeout time.Duration, isAccepted func(crypto.PubKey) bool,
+) (*p2pconn.SecretConnection, error) {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	sc, err := p2pconn.MakeSecretConnection(conn, identityKey)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "handshake failed")
+	}
+	if !isAccepted(sc.RemotePubKey()) {
+		conn.Close()
+		return nil, fmt.Errorf("unauthorized key %s", EncodePubKey(sc.RemotePubKey()))
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return sc, nil
+}
+
+func writeMsg(w io.Writer, msg RemoteSignerMsg) error {
+	_, err := cdc.MarshalBinaryLengthPrefixedWriter(w, msg)
+	return err
+}
+
+func readMsg(r io.Reader) (RemoteSignerMsg, error) {
+	var msg RemoteSignerMsg
+	_, err := cdc.UnmarshalBinaryLengthPrefixedReader(r, &msg, maxMsgSize)
+	return msg, err
+}
@@ -0,0 +1,94 @@
+package remotepv
+
+import (
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Max size of a single message exchanged between the node & the remote signer
+const maxMsgSize = 64 * 1024
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cryptoAmino.RegisterAmino(cdc)
+
+	cdc.RegisterInterface((*RemoteSignerMsg)(nil), nil)
+	cdc.RegisterConcrete(&PubKeyRequest{}, "loomchain/remotesigner/PubKeyRequest", nil)
+	cdc.RegisterConcrete(&PubKeyResponse{}, "loomchain/remotesigner/PubKeyResponse", nil)
+	cdc.RegisterConcrete(&SignVoteRequest{}, "loomchain/remotesigner/SignVoteRequest", nil)
+	cdc.RegisterConcrete(&SignedVoteResponse{}, "loomchain/remotesigner/SignedVoteResponse", nil)
+	cdc.RegisterConcrete(&SignProposalRequest{}, "loomchain/remotesigner/SignProposalRequest", nil)
+	cdc.RegisterConcrete(&SignedProposalResponse{}, "loomchain/remotesigner/SignedProposalResponse", nil)
+	cdc.RegisterConcrete(&SignBytesRequest{}, "loomchain/remotesigner/SignBytesRequest", nil)
+	cdc.RegisterConcrete(&SignedBytesResponse{}, "loomchain/remotesigner/SignedBytesResponse", nil)
+}
+
+// RemoteSignerMsg is implemented by all the messages exchanged between the node & the remote signer
+type RemoteSignerMsg interface{}
+
+// RemoteSignerError is returned by the remote signer when it refuses to, or is unable to, sign
+// a request.
+type RemoteSignerError struct {
+	Description string
+}
+
+func (e *RemoteSignerError) Error() string {
+	return fmt.Sprintf("remote signer error: %s", e.Description)
+}
+
+// PubKeyRequest requests the public key of the validator key held by the remote signer
+type PubKeyRequest struct{}
+
+// PubKeyResponse is the response to PubKeyRequest
+type PubKeyResponse struct {
+	PubKey crypto.PubKey
+	Error  *RemoteSignerError
+}
+
+// SignVoteRequest requests a signature for a vote
+type SignVoteRequest struct {
+	ChainID string
+	Vote    *types.Vote
+}
+
+// SignedVoteResponse is the response to SignVoteRequest
+type SignedVoteResponse struct {
+	Vote  *types.Vote
+	Error *RemoteSignerError
+}
+
+// SignProposalRequest requests a signature for a proposal
+type SignProposalRequest struct {
+	ChainID  string
+	Proposal *types.Proposal
+}
+
+// SignedProposalResponse is the response to SignProposalRequest
+type SignedProposalResponse struct {
+	Proposal *types.Proposal
+	Error    *RemoteSignerError
+}
+
+// SignBytesRequest requests a signature for an arbitrary message, such as the votes exchanged
+// by the fnConsensus reactor or the txs the node signs on behalf of the validator.
+type SignBytesRequest struct {
+	Msg []byte
+}
+
+// SignedBytesResponse is the response to SignBytesRequest
+type SignedBytesResponse struct {
+	Signature []byte
+	Error     *RemoteSignerError
+}
+
+func newRemoteSignerError(err error) *RemoteSignerError {
+	if err == nil {
+		return nil
+	}
+	return &RemoteSignerError{Description: err.Error()}
+}
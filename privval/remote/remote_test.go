@@ -0,0 +1,144 @@
+package remotepv
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	fpv "github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/types"
+)
+
+const testChainID = "default"
+
+type testSigner struct {
+	pv      *fpv.FilePV
+	address string
+	pubKey  string
+	ln      net.Listener
+}
+
+func startTestSigner(t *testing.T, dir string, authorizedKeys []crypto.PubKey) *testSigner {
+	pv := fpv.GenFilePV(filepath.Join(dir, "priv_validator.json"))
+	identityKey, err := GenIdentityKey(filepath.Join(dir, "signer_identity.json"))
+	require.NoError(t, err)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	signer := NewSigner(pv, identityKey, authorizedKeys, testChainID, log.NewNopLogger())
+	go signer.Serve(ln)
+
+	return &testSigner{
+		pv:      pv,
+		address: "tcp://" + ln.Addr().String(),
+		pubKey:  EncodePubKey(identityKey.PubKey()),
+		ln:      ln,
+	}
+}
+
+func newTestVote(pv types.PrivValidator, height int64, blockHash []byte) *types.Vote {
+	return &types.Vote{
+		Type:             types.PrevoteType,
+		Height:           height,
+		Round:            0,
+		Timestamp:        time.Now(),
+		BlockID:          types.BlockID{Hash: blockHash},
+		ValidatorAddress: pv.GetPubKey().Address(),
+		ValidatorIndex:   0,
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote-signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	nodeKeyFile := filepath.Join(dir, "node_identity.json")
+	nodeKey, err := GenIdentityKey(nodeKeyFile)
+	require.NoError(t, err)
+
+	signer := startTestSigner(t, dir, []crypto.PubKey{nodeKey.PubKey()})
+	defer signer.ln.Close()
+
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.Address = signer.address
+	cfg.SignerPubKey = signer.pubKey
+	cfg.IdentityKeyFile = nodeKeyFile
+	cfg.MaxDialAttempts = 1
+
+	pv, err := NewRemoteSignerPV(cfg)
+	require.NoError(t, err)
+	defer pv.Close()
+	require.True(t, pv.GetPubKey().Equals(signer.pv.GetPubKey()))
+
+	vote := newTestVote(pv, 1, tmhash.Sum([]byte("block1")))
+	require.NoError(t, pv.SignVote(testChainID, vote))
+	require.True(t, pv.GetPubKey().VerifyBytes(vote.SignBytes(testChainID), vote.Signature))
+
+	// signing the same vote again should return the same signature
+	sameVote := *vote
+	sameVote.Signature = nil
+	require.NoError(t, pv.SignVote(testChainID, &sameVote))
+	require.Equal(t, vote.Signature, sameVote.Signature)
+
+	// signing a conflicting vote at the same height/round/step must be refused by the signer
+	conflictingVote := newTestVote(pv, 1, tmhash.Sum([]byte("block2")))
+	require.Error(t, pv.SignVote(testChainID, conflictingVote))
+
+	// as must votes for another chain
+	require.Error(t, pv.SignVote("other-chain", newTestVote(pv, 2, tmhash.Sum([]byte("block3")))))
+
+	msg := []byte("hello")
+	sig, err := pv.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, pv.GetPubKey().VerifyBytes(msg, sig))
+
+	// votes can't be signed as raw bytes since that would bypass the double-signing protection
+	_, err = pv.Sign(newTestVote(pv, 3, tmhash.Sum([]byte("block4"))).SignBytes(testChainID))
+	require.Error(t, err)
+
+	// the client should reconnect if the connection is dropped
+	pv.Close()
+	vote = newTestVote(pv, 4, tmhash.Sum([]byte("block5")))
+	require.NoError(t, pv.SignVote(testChainID, vote))
+}
+
+func TestRemoteSignerAuthentication(t *testing.T) {
+	dir, err := ioutil.TempDir("", "remote-signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	nodeKeyFile := filepath.Join(dir, "node_identity.json")
+	_, err = GenIdentityKey(nodeKeyFile)
+	require.NoError(t, err)
+	otherKey, err := GenIdentityKey(filepath.Join(dir, "other_identity.json"))
+	require.NoError(t, err)
+
+	// the node's key isn't authorized
+	signer := startTestSigner(t, dir, []crypto.PubKey{otherKey.PubKey()})
+	defer signer.ln.Close()
+
+	cfg := DefaultConfig()
+	cfg.Enabled = true
+	cfg.Address = signer.address
+	cfg.SignerPubKey = signer.pubKey
+	cfg.IdentityKeyFile = nodeKeyFile
+	cfg.MaxDialAttempts = 1
+
+	_, err = NewRemoteSignerPV(cfg)
+	require.Error(t, err)
+
+	// the signer must authenticate with the expected key
+	cfg.SignerPubKey = EncodePubKey(otherKey.PubKey())
+	_, err = NewRemoteSignerPV(cfg)
+	require.Error(t, err)
+}
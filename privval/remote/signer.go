@@ -0,0 +1,168 @@
+package remotepv
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Signer signs votes, proposals & messages for the nodes that connect to it, using a priv validator
+// that's only accessible to the signer. Double-signing protection is provided by the priv validator,
+// which persists the height/round/step it last signed on the signer host, so it's enforced even if
+// multiple nodes are connected to the same signer.
+type Signer struct {
+	pv             types.PrivValidator
+	identityKey    crypto.PrivKey
+	authorizedKeys []crypto.PubKey
+	chainID        string
+	timeout        time.Duration
+	logger         log.Logger
+
+	mtx sync.Mutex
+}
+
+// NewSigner creates a signer that only accepts connections from nodes that authenticate with one of
+// the authorized keys, and only signs votes & proposals for the given chain (or any chain if
+// chainID is empty).
+func NewSigner(
+	pv types.PrivValidator, identityKey crypto.PrivKey, authorizedKeys []crypto.PubKey, chainID string,
+	logger log.Logger,
+) *Signer {
+	return &Signer{
+		pv:             pv,
+		identityKey:    identityKey,
+		authorizedKeys: authorizedKeys,
+		chainID:        chainID,
+		timeout:        10 * time.Second,
+		logger:         logger,
+	}
+}
+
+// Serve handles connections accepted by the listener until the listener is closed.
+func (s *Signer) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handleConn(conn)
+	}
+}
+
+func (s *Signer) handleConn(conn net.Conn) {
+	remoteAddr := conn.RemoteAddr()
+	sc, err := makeAuthenticatedConn(conn, s.identityKey, s.timeout, s.isAuthorized)
+	if err != nil {
+		s.logger.Error("Rejected connection", "remote", remoteAddr, "err", err)
+		return
+	}
+	defer sc.Close()
+	s.logger.Info("Accepted connection", "remote", remoteAddr, "key", EncodePubKey(sc.RemotePubKey()))
+
+	for {
+		req, err := readMsg(sc)
+		if err != nil {
+			if err != io.EOF {
+				s.logger.Error("Failed to read request", "remote", remoteAddr, "err", err)
+			}
+			return
+		}
+		if err := writeMsg(sc, s.handleRequest(req)); err != nil {
+			s.logger.Error("Failed to write response", "remote", remoteAddr, "err", err)
+			return
+		}
+	}
+}
+
+func (s *Signer) handleRequest(req RemoteSignerMsg) RemoteSignerMsg {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	switch r := req.(type) {
+	case *PubKeyRequest:
+		return &PubKeyResponse{PubKey: s.pv.GetPubKey()}
+
+	case *SignVoteRequest:
+		if err := s.checkChainID(r.ChainID); err != nil {
+			return &SignedVoteResponse{Error: newRemoteSignerError(err)}
+		}
+		if r.Vote == nil {
+			return &SignedVoteResponse{Error: newRemoteSignerError(errors.New("vote not specified"))}
+		}
+		if err := s.pv.SignVote(r.ChainID, r.Vote); err != nil {
+			s.logger.Error("Failed to sign vote", "height", r.Vote.Height, "round", r.Vote.Round, "err", err)
+			return &SignedVoteResponse{Error: newRemoteSignerError(err)}
+		}
+		return &SignedVoteResponse{Vote: r.Vote}
+
+	case *SignProposalRequest:
+		if err := s.checkChainID(r.ChainID); err != nil {
+			return &SignedProposalResponse{Error: newRemoteSignerError(err)}
+		}
+		if r.Proposal == nil {
+			return &SignedProposalResponse{Error: newRemoteSignerError(errors.New("proposal not specified"))}
+		}
+		if err := s.pv.SignProposal(r.ChainID, r.Proposal); err != nil {
+			s.logger.Error(
+				"Failed to sign proposal", "height", r.Proposal.Height, "round", r.Proposal.Round, "err", err,
+			)
+			return &SignedProposalResponse{Error: newRemoteSignerError(err)}
+		}
+		return &SignedProposalResponse{Proposal: r.Proposal}
+
+	case *SignBytesRequest:
+		// Votes & proposals must be signed via SignVote & SignProposal, otherwise they'd bypass the
+		// double-signing protection.
+		if isConsensusMsg(r.Msg) {
+			return &SignedBytesResponse{
+				Error: newRemoteSignerError(errors.New("refusing to sign vote or proposal as raw bytes")),
+			}
+		}
+		sig, err := s.pv.Sign(r.Msg)
+		if err != nil {
+			return &SignedBytesResponse{Error: newRemoteSignerError(err)}
+		}
+		return &SignedBytesResponse{Signature: sig}
+
+	default:
+		return &SignedBytesResponse{Error: newRemoteSignerError(fmt.Errorf("unknown request %T", req))}
+	}
+}
+
+func (s *Signer) isAuthorized(pubKey crypto.PubKey) bool {
+	for _, authorizedKey := range s.authorizedKeys {
+		if authorizedKey.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Signer) checkChainID(chainID string) error {
+	if s.chainID != "" && s.chainID != chainID {
+		return fmt.Errorf("unexpected chain ID %s", chainID)
+	}
+	return nil
+}
+
+// isConsensusMsg returns true if msg can be decoded as the sign bytes of a vote or proposal.
+func isConsensusMsg(msg []byte) bool {
+	var vote types.CanonicalVote
+	if err := cdc.UnmarshalBinaryLengthPrefixed(msg, &vote); err == nil && vote.Height > 0 &&
+		(vote.Type == types.PrevoteType || vote.Type == types.PrecommitType) {
+		return true
+	}
+	var proposal types.CanonicalProposal
+	if err := cdc.UnmarshalBinaryLengthPrefixed(msg, &proposal); err == nil && proposal.Height > 0 &&
+		proposal.Type == types.ProposalType {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package privval
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
+	remotepv "github.com/loomnetwork/loomchain/privval/remote"
+)
+
+var errRemoteSignState = errors.New(
+	"the double-signing protection state of a remote signer can only be accessed on the signer host",
+)
+
+// SignState is the double-signing protection state of a priv validator, i.e. the height/round/step
+// of the last vote or proposal signed by the validator. A priv validator refuses to sign anything
+// at a lower height/round/step, so when a validator is moved to another host the state should be
+// exported from the old host & imported on the new one.
+type SignState struct {
+	PubKey        []byte       `json:"pub_key"`
+	LastHeight    int64        `json:"last_height"`
+	LastRound     int          `json:"last_round"`
+	LastStep      int8         `json:"last_step"`
+	LastSignature []byte       `json:"last_signature,omitempty"`
+	LastSignBytes cmn.HexBytes `json:"last_signbytes,omitempty"`
+}
+
+// returns true if the state is at a lower height/round/step than the other state
+func (s *SignState) isBehind(other *SignState) bool {
+	if s.LastHeight != other.LastHeight {
+		return s.LastHeight < other.LastHeight
+	}
+	if s.LastRound != other.LastRound {
+		return s.LastRound < other.LastRound
+	}
+	return s.LastStep < other.LastStep
+}
+
+// signStateFields points to the fields that store the double-signing protection state of a
+// priv validator
+type signStateFields struct {
+	lastHeight    *int64
+	lastRound     *int
+	lastStep      *int8
+	lastSignature *[]byte
+	lastSignBytes *cmn.HexBytes
+}
+
+func getSignStateFields(pv PrivValidator) (*signStateFields, error) {
+	switch v := pv.(type) {
+	case *FilePV:
+		return &signStateFields{&v.LastHeight, &v.LastRound, &v.LastStep, &v.LastSignature, &v.LastSignBytes}, nil
+	case *hsmpv.YubiHsmPV:
+		return &signStateFields{&v.LastHeight, &v.LastRound, &v.LastStep, &v.LastSignature, &v.LastSignBytes}, nil
+	case *hsmpv.Pkcs11PV:
+		return &signStateFields{&v.LastHeight, &v.LastRound, &v.LastStep, &v.LastSignature, &v.LastSignBytes}, nil
+	case *remotepv.RemoteSignerPV:
+		return nil, errRemoteSignState
+	default:
+		return nil, fmt.Errorf("Unknown PrivValidator implementation %T", v)
+	}
+}
+
+func pubKeyBytes(pv PrivValidator) []byte {
+	pubKey := [ed25519.PubKeyEd25519Size]byte(pv.GetPubKey().(ed25519.PubKeyEd25519))
+	return pubKey[:]
+}
+
+// GetSignState returns the double-signing protection state of the priv validator.
+func GetSignState(pv PrivValidator) (*SignState, error) {
+	fields, err := getSignStateFields(pv)
+	if err != nil {
+		return nil, err
+	}
+	return &SignState{
+		PubKey:        pubKeyBytes(pv),
+		LastHeight:    *fields.lastHeight,
+		LastRound:     *fields.lastRound,
+		LastStep:      *fields.lastStep,
+		LastSignature: *fields.lastSignature,
+		LastSignBytes: *fields.lastSignBytes,
+	}, nil
+}
+
+// ImportSignState replaces the double-signing protection state of the priv validator with the given
+// state, which must belong to the same validator key. The state will only be replaced if the given
+// state is ahead of the current state, a state that's behind the current state is rejected since
+// importing it could allow the validator to double-sign. Returns true if the state was replaced.
+func ImportSignState(pv PrivValidator, state *SignState) (bool, error) {
+	current, err := GetSignState(pv)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(current.PubKey, state.PubKey) {
+		return false, errors.New("sign state belongs to a different validator key")
+	}
+	if state.isBehind(current) {
+		return false, fmt.Errorf(
+			"sign state is behind the current state (height %d, round %d, step %d)",
+			current.LastHeight, current.LastRound, current.LastStep,
+		)
+	}
+	if !current.isBehind(state) {
+		return false, nil
+	}
+
+	fields, err := getSignStateFields(pv)
+	if err != nil {
+		return false, err
+	}
+	*fields.lastHeight = state.LastHeight
+	*fields.lastRound = state.LastRound
+	*fields.lastStep = state.LastStep
+	*fields.lastSignature = state.LastSignature
+	*fields.lastSignBytes = state.LastSignBytes
+	pv.Save()
+	return true, nil
+}
+
+// EnforceSafetyHeight ensures the priv validator won't sign any vote or proposal below the given
+// height by raising the double-signing protection state to the given height if it's currently
+// lower. Returns true if the state was raised. The safety height of a remote signer must be
+// enforced on the signer host, so it's ignored for remote signers.
+func EnforceSafetyHeight(pv PrivValidator, height int64) (bool, error) {
+	if height <= 0 {
+		return false, nil
+	}
+	if _, ok := pv.(*remotepv.RemoteSignerPV); ok {
+		return false, nil
+	}
+
+	fields, err := getSignStateFields(pv)
+	if err != nil {
+		return false, err
+	}
+	if *fields.lastHeight >= height {
+		return false, nil
+	}
+	*fields.lastHeight = height
+	*fields.lastRound = 0
+	*fields.lastStep = 0
+	*fields.lastSignature = nil
+	*fields.lastSignBytes = nil
+	pv.Save()
+	return true, nil
+}
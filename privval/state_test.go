@@ -0,0 +1,94 @@
+package privval
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/types"
+)
+
+func newTestVote(pv PrivValidator, height int64, round int) *types.Vote {
+	return &types.Vote{
+		Type:             types.PrecommitType,
+		Height:           height,
+		Round:            round,
+		Timestamp:        time.Now(),
+		BlockID:          types.BlockID{Hash: tmhash.Sum([]byte("block"))},
+		ValidatorAddress: pv.GetPubKey().Address(),
+	}
+}
+
+func TestSignStateExportImport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "privval")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pvFile := filepath.Join(dir, "priv_validator.json")
+	oldPV, err := GenFilePV(pvFile)
+	require.NoError(t, err)
+	oldPV.Save()
+	require.NoError(t, oldPV.SignVote("default", newTestVote(oldPV, 10, 1)))
+
+	// copy the key to a new file, but without the sign state, as could happen when moving the
+	// validator to another node
+	newPVFile := filepath.Join(dir, "new_priv_validator.json")
+	newPV, err := GenFilePV(newPVFile)
+	require.NoError(t, err)
+	newPV.PrivKey = oldPV.PrivKey
+	newPV.PubKey = oldPV.PubKey
+	newPV.Address = oldPV.Address
+	newPV.Save()
+
+	state, err := GetSignState(oldPV)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), state.LastHeight)
+	require.Equal(t, 1, state.LastRound)
+
+	// the state of another key can't be imported
+	otherPV, err := GenFilePV(filepath.Join(dir, "other_priv_validator.json"))
+	require.NoError(t, err)
+	_, err = ImportSignState(otherPV, state)
+	require.Error(t, err)
+
+	imported, err := ImportSignState(newPV, state)
+	require.NoError(t, err)
+	require.True(t, imported)
+	imported, err = ImportSignState(newPV, state)
+	require.NoError(t, err)
+	require.False(t, imported)
+
+	// the imported state should've been persisted, and should prevent double-signing
+	newPV, err = LoadFilePV(newPVFile)
+	require.NoError(t, err)
+	require.Error(t, newPV.SignVote("default", newTestVote(newPV, 10, 0)))
+	require.NoError(t, newPV.SignVote("default", newTestVote(newPV, 11, 0)))
+
+	// a state that's behind the current state is rejected
+	_, err = ImportSignState(newPV, state)
+	require.Error(t, err)
+}
+
+func TestEnforceSafetyHeight(t *testing.T) {
+	dir, err := ioutil.TempDir("", "privval")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pv, err := GenFilePV(filepath.Join(dir, "priv_validator.json"))
+	require.NoError(t, err)
+	pv.Save()
+
+	raised, err := EnforceSafetyHeight(pv, 100)
+	require.NoError(t, err)
+	require.True(t, raised)
+	raised, err = EnforceSafetyHeight(pv, 50)
+	require.NoError(t, err)
+	require.False(t, raised)
+
+	require.Error(t, pv.SignVote("default", newTestVote(pv, 99, 0)))
+	require.NoError(t, pv.SignVote("default", newTestVote(pv, 100, 0)))
+}
@@ -168,7 +168,7 @@ func (r *ReceiptHandler) CacheReceipt(
 	}
 	receipt, err := leveldb.WriteReceipt(
 		state.Block(), caller, addr, events, status, r.eventHandler,
-		int32(len(r.receiptsCache)), int64(auth.Nonce(state, caller)), txHash,
+		int32(len(r.receiptsCache)), int64(auth.TxNonce(state, caller)), txHash,
 	)
 	if err != nil {
 		return []byte{}, errors.Wrap(err, "receipt not written, returning empty hash")
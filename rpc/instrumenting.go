@@ -69,6 +69,18 @@ func (m InstrumentingMiddleware) Nonce(key, account string) (resp uint64, err er
 	return
 }
 
+// LaneNonce call service LaneNonce method and captures metrics
+func (m InstrumentingMiddleware) LaneNonce(key, account string, lane uint32) (resp uint64, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "LaneNonce", "error", fmt.Sprint(err != nil)}
+		m.requestCount.With(lvs...).Add(1)
+		m.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	resp, err = m.next.LaneNonce(key, account, lane)
+	return
+}
+
 func (m InstrumentingMiddleware) Subscribe(wsCtx rpctypes.WSRPCContext, contracts []string) (*WSEmptyResult, error) {
 	return m.next.Subscribe(wsCtx, contracts)
 }
@@ -139,6 +151,30 @@ func (m InstrumentingMiddleware) DPOSTotalStaked() (resp *DPOSTotalStakedRespons
 	return
 }
 
+func (m InstrumentingMiddleware) ListAddressMappings(
+	chainID string, cursor string, limit uint32,
+) (resp *ListAddressMappingsResponse, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "ListAddressMappings", "error", fmt.Sprint(err != nil)}
+		m.requestCount.With(lvs...).Add(1)
+		m.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	resp, err = m.next.ListAddressMappings(chainID, cursor, limit)
+	return
+}
+
+func (m InstrumentingMiddleware) AddressMappingCount(chainID string) (resp uint64, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "AddressMappingCount", "error", fmt.Sprint(err != nil)}
+		m.requestCount.With(lvs...).Add(1)
+		m.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	resp, err = m.next.AddressMappingCount(chainID)
+	return
+}
+
 func (m InstrumentingMiddleware) GetCanonicalTxHash(block, txIndex uint64, evmTxHash eth.Data) (resp eth.Data, err error) {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "GetCanonicalTxHash", "error", fmt.Sprint(err != nil)}
@@ -39,6 +39,13 @@ func (m *MockQueryService) Nonce(key, account string) (uint64, error) {
 	return 0, nil
 }
 
+func (m *MockQueryService) LaneNonce(key, account string, lane uint32) (uint64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.MethodsCalled = append([]string{"LaneNonce"}, m.MethodsCalled...)
+	return 0, nil
+}
+
 func (m *MockQueryService) Subscribe(wsCtx rpctypes.WSRPCContext, topics []string) (*WSEmptyResult, error) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -275,6 +282,18 @@ func (m *MockQueryService) DPOSTotalStaked() (*DPOSTotalStakedResponse, error) {
 	return nil, nil
 }
 
+func (m *MockQueryService) ListAddressMappings(
+	chainID string, cursor string, limit uint32,
+) (*ListAddressMappingsResponse, error) {
+	m.MethodsCalled = append([]string{"ListAddressMappings"}, m.MethodsCalled...)
+	return nil, nil
+}
+
+func (m *MockQueryService) AddressMappingCount(chainID string) (uint64, error) {
+	m.MethodsCalled = append([]string{"AddressMappingCount"}, m.MethodsCalled...)
+	return 0, nil
+}
+
 func (m *MockQueryService) GetCanonicalTxHash(block, txIndex uint64, evmTxHash eth.Data) (eth.Data, error) {
 	m.MethodsCalled = append([]string{"GetCanonicalTxHash"}, m.MethodsCalled...)
 	return "", nil
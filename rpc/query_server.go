@@ -27,6 +27,7 @@ import (
 	"github.com/loomnetwork/go-loom/vm"
 	"github.com/loomnetwork/loomchain"
 	"github.com/loomnetwork/loomchain/auth"
+	"github.com/loomnetwork/loomchain/builtin/plugins/address_mapper"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
 	"github.com/loomnetwork/loomchain/builtin/plugins/ethcoin"
 	"github.com/loomnetwork/loomchain/config"
@@ -35,6 +36,7 @@ import (
 	"github.com/loomnetwork/loomchain/eth/subs"
 	"github.com/loomnetwork/loomchain/eth/utils"
 	levm "github.com/loomnetwork/loomchain/evm"
+	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/log"
 	lcp "github.com/loomnetwork/loomchain/plugin"
 	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
@@ -383,6 +385,15 @@ func (s *QueryServer) createStaticContractCtx(state loomchain.State, name string
 // NOTE: Either the key or the account must be provided. The account (if not empty) is used in
 //       preference to the key.
 func (s *QueryServer) Nonce(key, account string) (uint64, error) {
+	return s.LaneNonce(key, account, 0)
+}
+
+// LaneNonce returns the sequence number of the last committed tx sent by the given account in the
+// given nonce lane, the next tx sent in the lane must have the sequence number returned by
+// auth.EncodeLaneNonce(lane, seq + 1).
+// NOTE: Either the key or the account must be provided. The account (if not empty) is used in
+//       preference to the key.
+func (s *QueryServer) LaneNonce(key, account string, lane uint32) (uint64, error) {
 	var addr loom.Address
 
 	if key != "" && account == "" {
@@ -412,7 +423,7 @@ func (s *QueryServer) Nonce(key, account string) (uint64, error) {
 		return 0, errors.Wrap(err, "failed to resolve account address")
 	}
 
-	return auth.Nonce(snapshot, resolvedAddr), nil
+	return auth.LaneNonce(snapshot, resolvedAddr, lane), nil
 }
 
 func (s *QueryServer) Resolve(name string) (string, error) {
@@ -640,6 +651,86 @@ func (s *QueryServer) DPOSTotalStaked() (*DPOSTotalStakedResponse, error) {
 	}, nil
 }
 
+type AddressMapping struct {
+	Foreign string
+	Local   string
+}
+
+type ListAddressMappingsResponse struct {
+	Mappings []*AddressMapping
+	// Hex-encoded cursor that should be passed in to retrieve the next page of mappings, empty if
+	// there are no more mappings.
+	NextCursor string
+}
+
+// ListAddressMappings returns a page of account mappings from the Address Mapper contract,
+// optionally limited to mappings to accounts on the given foreign chain.
+func (s *QueryServer) ListAddressMappings(chainID string, cursor string, limit uint32) (*ListAddressMappingsResponse, error) {
+	var cursorBytes []byte
+	if cursor != "" {
+		var err error
+		cursorBytes, err = hex.DecodeString(strings.TrimPrefix(cursor, "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid cursor")
+		}
+	}
+
+	snapshot := s.StateProvider.ReadOnlyState()
+	defer snapshot.Release()
+
+	mapperCtx, err := s.createAddressMapperCtx(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if !mapperCtx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		return nil, errors.New("address mapping index is not enabled")
+	}
+	resp, err := address_mapper.ListMappingPage(mapperCtx, &address_mapper.ListMappingPageRequest{
+		ChainId: chainID,
+		Cursor:  cursorBytes,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListAddressMappingsResponse{
+		Mappings: make([]*AddressMapping, 0, len(resp.Mappings)),
+	}
+	for _, mapping := range resp.Mappings {
+		result.Mappings = append(result.Mappings, &AddressMapping{
+			Foreign: loom.UnmarshalAddressPB(mapping.Foreign).String(),
+			Local:   loom.UnmarshalAddressPB(mapping.Local).String(),
+		})
+	}
+	if len(resp.NextCursor) > 0 {
+		result.NextCursor = "0x" + hex.EncodeToString(resp.NextCursor)
+	}
+	return result, nil
+}
+
+// AddressMappingCount returns the number of account mappings in the Address Mapper contract,
+// optionally limited to mappings to accounts on the given foreign chain.
+func (s *QueryServer) AddressMappingCount(chainID string) (uint64, error) {
+	snapshot := s.StateProvider.ReadOnlyState()
+	defer snapshot.Release()
+
+	mapperCtx, err := s.createAddressMapperCtx(snapshot)
+	if err != nil {
+		return 0, err
+	}
+	if !mapperCtx.FeatureEnabled(features.AddressMapperVersion1_3, false) {
+		return 0, errors.New("address mapping index is not enabled")
+	}
+	resp, err := address_mapper.GetMappingCount(mapperCtx, &address_mapper.GetMappingCountRequest{
+		ChainId: chainID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
+
 // GetCanonicalTxHash returns the hash of the Tendermint tx payload within a block.
 // If the block number is specified (non-zero) then the tx payload will be found by block number & tx
 // index. Otherwise the EVM tx hash will be used to lookup the receipt for the tx and the block
@@ -2,6 +2,7 @@ package store
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-kit/kit/metrics"
@@ -48,6 +49,13 @@ type IAVLStore struct {
 	tree          *iavl.MutableTree
 	maxVersions   int64 // maximum number of versions to keep when pruning
 	flushInterval int64 // how often we persist to disk
+	// flush interval specified in the on-chain config, only used when flushInterval is zero,
+	// -1 until SetOnChainFlushInterval is called, must be accessed atomically
+	onChainFlushInterval int64
+	// version that must be written to disk when it's saved regardless of the flush interval,
+	// must be accessed atomically
+	forcedFlushVersion int64
+	db                 dbm.DB
 }
 
 func (s *IAVLStore) Delete(key []byte) {
@@ -149,14 +157,14 @@ func (s *IAVLStore) SaveVersion() ([]byte, int64, error) {
 	oldVersion := s.Version()
 	flushInterval := s.flushInterval
 
-	// TODO: Rather than loading the on-chain config here the flush interval override should be passed
-	//       in as a parameter to SaveVersion().
 	if flushInterval == 0 {
-		cfg, err := LoadOnChainConfig(s)
-		if err != nil {
-			return nil, 0, errors.Wrap(err, "failed to load on-chain config")
-		}
-		if cfg.GetAppStore().GetIAVLFlushInterval() != 0 {
+		flushInterval = atomic.LoadInt64(&s.onChainFlushInterval)
+		// Stores that aren't kept in sync with the on-chain config by the app have to look it up.
+		if flushInterval == -1 {
+			cfg, err := LoadOnChainConfig(s)
+			if err != nil {
+				return nil, 0, errors.Wrap(err, "failed to load on-chain config")
+			}
 			flushInterval = int64(cfg.GetAppStore().GetIAVLFlushInterval())
 		}
 	} else if flushInterval == -1 {
@@ -166,7 +174,8 @@ func (s *IAVLStore) SaveVersion() ([]byte, int64, error) {
 	var version int64
 	var hash []byte
 	// Every X versions we should persist to disk
-	if flushInterval == 0 || ((oldVersion+1)%flushInterval == 0) {
+	forceFlush := atomic.LoadInt64(&s.forcedFlushVersion) == oldVersion+1
+	if flushInterval == 0 || forceFlush || ((oldVersion+1)%flushInterval == 0) {
 		if flushInterval != 0 {
 			log.Info("[IAVLStore] Flushing mem to disk", "version", oldVersion+1)
 			hash, version, err = s.tree.FlushMemVersionDisk()
@@ -183,6 +192,25 @@ func (s *IAVLStore) SaveVersion() ([]byte, int64, error) {
 	return hash, version, nil
 }
 
+// SetOnChainFlushInterval sets the flush interval that should be used when the store was created
+// with a zero flush interval, this should be called whenever AppStore.IAVLFlushInterval changes in
+// the on-chain config. Until this is called the store loads the on-chain config itself each time
+// a version is saved.
+func (s *IAVLStore) SetOnChainFlushInterval(flushInterval int64) {
+	atomic.StoreInt64(&s.onChainFlushInterval, flushInterval)
+}
+
+// FlushVersion ensures the given version will be written to disk when it's saved, regardless of
+// the flush interval.
+func (s *IAVLStore) FlushVersion(version int64) {
+	atomic.StoreInt64(&s.forcedFlushVersion, version)
+}
+
+// Close closes the underlying database, any versions that haven't been flushed to disk are lost.
+func (s *IAVLStore) Close() {
+	s.db.Close()
+}
+
 func (s *IAVLStore) Prune() error {
 	// keep all the versions
 	if s.maxVersions == 0 {
@@ -242,9 +270,11 @@ func NewIAVLStore(db dbm.DB, maxVersions, targetVersion, flushInterval int64) (*
 	}
 
 	return &IAVLStore{
-		tree:          tree,
-		maxVersions:   maxVersions,
-		flushInterval: flushInterval,
+		tree:                 tree,
+		maxVersions:          maxVersions,
+		flushInterval:        flushInterval,
+		onChainFlushInterval: -1,
+		db:                   db,
 	}, nil
 }
 
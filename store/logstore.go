@@ -55,6 +55,14 @@ func NewLogStore(store VersionedKVStore) (ls *LogStore, err error) {
 	return ls, nil
 }
 
+func (s *LogStore) FlushVersion(version int64) {
+	FlushVersion(s.store, version)
+}
+
+func (s *LogStore) Close() {
+	Close(s.store)
+}
+
 func (s *LogStore) Delete(key []byte) {
 	if s.params.LogDelete {
 		s.logger.Println("Delete key: ", string(key))
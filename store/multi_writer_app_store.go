@@ -182,6 +182,18 @@ func (s *MultiWriterAppStore) Version() int64 {
 	return s.appStore.Version()
 }
 
+// FlushVersion ensures the given version of the IAVL tree will be written to disk when it's saved,
+// the EVM state is always written to disk.
+func (s *MultiWriterAppStore) FlushVersion(version int64) {
+	s.appStore.FlushVersion(version)
+}
+
+// Close closes the app & EVM databases.
+func (s *MultiWriterAppStore) Close() {
+	s.appStore.Close()
+	s.evmStore.evmDB.Close()
+}
+
 func (s *MultiWriterAppStore) SaveVersion() ([]byte, int64, error) {
 	var err error
 	defer func(begin time.Time) {
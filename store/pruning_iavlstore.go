@@ -112,6 +112,25 @@ func NewPruningIAVLStore(db dbm.DB, cfg PruningIAVLStoreConfig) (*PruningIAVLSto
 	return s, nil
 }
 
+// SetOnChainFlushInterval sets the flush interval the underlying IAVLStore should use when it was
+// created with a zero flush interval.
+func (s *PruningIAVLStore) SetOnChainFlushInterval(flushInterval int64) {
+	s.store.SetOnChainFlushInterval(flushInterval)
+}
+
+// FlushVersion ensures the given version will be written to disk when it's saved.
+func (s *PruningIAVLStore) FlushVersion(version int64) {
+	s.store.FlushVersion(version)
+}
+
+// Close closes the underlying database.
+func (s *PruningIAVLStore) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.store.Close()
+}
+
 func (s *PruningIAVLStore) Delete(key []byte) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
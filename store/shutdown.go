@@ -0,0 +1,29 @@
+package store
+
+// versionFlusher is implemented by stores that may keep saved versions in memory instead of writing
+// them to disk straight away.
+type versionFlusher interface {
+	FlushVersion(version int64)
+}
+
+// closer is implemented by stores that hold on to databases that must be closed on shutdown.
+type closer interface {
+	Close()
+}
+
+// FlushVersion ensures the given version of the store will be written to disk when it's saved,
+// regardless of the flush interval the store is using. This is a no-op for stores that always
+// write every version to disk.
+func FlushVersion(s VersionedKVStore, version int64) {
+	if f, ok := s.(versionFlusher); ok {
+		f.FlushVersion(version)
+	}
+}
+
+// Close closes the databases the store reads from & writes to, the store must not be used after
+// it's been closed. This is a no-op for stores that don't own any databases.
+func Close(s VersionedKVStore) {
+	if c, ok := s.(closer); ok {
+		c.Close()
+	}
+}
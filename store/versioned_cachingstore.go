@@ -322,6 +322,14 @@ func NewVersionedCachingStore(
 	}, nil
 }
 
+func (c *versionedCachingStore) FlushVersion(version int64) {
+	FlushVersion(c.VersionedKVStore, version)
+}
+
+func (c *versionedCachingStore) Close() {
+	Close(c.VersionedKVStore)
+}
+
 func (c *versionedCachingStore) Delete(key []byte) {
 	var err error
 
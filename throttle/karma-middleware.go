@@ -41,6 +41,9 @@ func GetKarmaMiddleWare(
 		if origin.IsEmpty() {
 			return res, errors.New("throttle: transaction has no origin [get-karma]")
 		}
+		// Karma is charged to the relayer when a tx is relayed, the origin still owns any contracts
+		// deployed by the tx.
+		payer := auth.Payer(state.Context())
 
 		var nonceTx lauth.NonceTx
 		if err := proto.Unmarshal(txBytes, &nonceTx); err != nil {
@@ -107,7 +110,7 @@ func GetKarmaMiddleWare(
 		if err != nil {
 			return res, errors.Wrap(err, "failed to obtain Karma Oracle address")
 		}
-		if oracleAddr != nil && origin.Compare(*oracleAddr) == 0 {
+		if oracleAddr != nil && payer.Compare(*oracleAddr) == 0 {
 			r, err := next(state, txBytes, isCheckTx)
 			if err != nil {
 				return r, err
@@ -124,7 +127,7 @@ func GetKarmaMiddleWare(
 			return r, nil
 		}
 
-		originKarma, err := th.getKarmaForTransaction(ctx, origin, isDeployTx)
+		originKarma, err := th.getKarmaForTransaction(ctx, payer, isDeployTx)
 		if err != nil {
 			return res, errors.Wrap(err, "getting total karma")
 		}
@@ -159,7 +162,7 @@ func GetKarmaMiddleWare(
 			if originKarmaTotal > math.MaxInt64-th.maxCallCount {
 				callCount = math.MaxInt64
 			}
-			err := th.runThrottle(state, nonceTx.Sequence, origin, callCount, tx.Id, karmaMiddlewareThrottleKey)
+			err := th.runThrottle(state, nonceTx.Sequence, payer, callCount, tx.Id, karmaMiddlewareThrottleKey)
 			if err != nil {
 				return res, errors.Wrap(err, "call karma throttle")
 			}
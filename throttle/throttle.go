@@ -26,7 +26,8 @@ type Throttle struct {
 	deployLimiterPool    map[string]*limiter.Limiter
 	karmaContractAddress loom.Address
 
-	lastAddress        string
+	lastPayer          string
+	lastOrigin         string
 	lastLimiterContext limiter.Context
 	lastNonce          uint64
 	lastId             uint32
@@ -54,8 +55,9 @@ func (t *Throttle) getNewLimiter(ctx context.Context, limit int64) *limiter.Limi
 	return limiter.New(limiterStore, rate)
 }
 
+// getLimiterFromPool returns the limiter of the account that pays for the current tx.
 func (t *Throttle) getLimiterFromPool(ctx context.Context, limit int64) *limiter.Limiter {
-	address := auth.Origin(ctx).String()
+	address := auth.Payer(ctx).String()
 	_, ok := t.callLimiterPool[address]
 	if !ok {
 		t.callLimiterPool[address] = t.getNewLimiter(ctx, limit)
@@ -68,14 +70,19 @@ func (t *Throttle) getLimiterFromPool(ctx context.Context, limit int64) *limiter
 	return t.callLimiterPool[address]
 }
 
+// getLimiterContext returns the limiter context of the account that pays for the current tx. The
+// nonce belongs to the tx origin, which differs from the payer when the tx is relayed, so the last
+// limiter context is only reused for the same payer, origin, and nonce.
 func (t *Throttle) getLimiterContext(
 	ctx context.Context, nonce uint64, limit int64, txId uint32, key string,
 ) (limiter.Context, error) {
-	address := auth.Origin(ctx).String()
-	if address == t.lastAddress && nonce == t.lastNonce && t.lastId == txId {
+	payer := auth.Payer(ctx).String()
+	origin := auth.Origin(ctx).String()
+	if payer == t.lastPayer && origin == t.lastOrigin && nonce == t.lastNonce && t.lastId == txId {
 		return t.lastLimiterContext, nil
 	} else {
-		t.lastAddress = address
+		t.lastPayer = payer
+		t.lastOrigin = origin
 		t.lastNonce = nonce
 		t.lastId = txId
 		limiterCtx, err := t.getLimiterFromPool(ctx, limit).Get(ctx, key)
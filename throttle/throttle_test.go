@@ -278,3 +278,32 @@ func ethTxBytes(sequence uint64, to loom.Address, data []byte) ([]byte, error) {
 	}
 	return rlp.EncodeToBytes(&tx)
 }
+
+func TestThrottleLimiterContextRelayedTxs(t *testing.T) {
+	relayer := loom.MustParseAddress("chain:0x3bA260874e6Ada53d4e0010fdE38cf2CD072A1be")
+	user1 := loom.MustParseAddress("chain:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	user2 := loom.MustParseAddress("chain:0xfa4c7920accfd66b86f5fd0e69682a79f762d49e")
+	relayedCtx := func(origin loom.Address) context.Context {
+		ctx := context.WithValue(context.Background(), loomAuth.ContextKeyOrigin, origin)
+		return context.WithValue(ctx, loomAuth.ContextKeyRelayer, relayer)
+	}
+
+	th := NewThrottle(600, 10)
+	limiterCtx, err := th.getLimiterContext(relayedCtx(user1), 1, 10, 1, "key")
+	require.NoError(t, err)
+	require.Equal(t, int64(9), limiterCtx.Remaining)
+	// the same tx is only counted once
+	limiterCtx, err = th.getLimiterContext(relayedCtx(user1), 1, 10, 1, "key")
+	require.NoError(t, err)
+	require.Equal(t, int64(9), limiterCtx.Remaining)
+	// relayed txs of different users with the same nonce are all charged to the relayer
+	limiterCtx, err = th.getLimiterContext(relayedCtx(user2), 1, 10, 1, "key")
+	require.NoError(t, err)
+	require.Equal(t, int64(8), limiterCtx.Remaining)
+	// txs the user submits directly are charged to the user
+	limiterCtx, err = th.getLimiterContext(
+		context.WithValue(context.Background(), loomAuth.ContextKeyOrigin, user1), 2, 10, 1, "key",
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), limiterCtx.Remaining)
+}
@@ -84,7 +84,8 @@ func NewTxLimiterMiddleware(cfg *TxLimiterConfig) loomchain.TxMiddlewareFunc {
 			return loomchain.TxHandlerResult{}, errors.New("throttle: transaction has no origin [get-karma]")
 		}
 
-		if txl.isAccountLimitReached(origin) {
+		// relayed txs count towards the limit of the relayer
+		if txl.isAccountLimitReached(auth.Payer(state.Context())) {
 			return loomchain.TxHandlerResult{}, errors.New("tx limit reached, try again later")
 		}
 